@@ -0,0 +1,13 @@
+# Fail the plan when any high priority check fails on the latest processed
+# snapshot.
+data "forwardnetworks_check_results" "high" {
+  network_id = "159780"
+  priority   = "HIGH"
+
+  lifecycle {
+    postcondition {
+      condition     = self.all_passed
+      error_message = "Forward Networks checks are failing: ${jsonencode([for c in self.checks : c.name if c.status != "PASS"])}"
+    }
+  }
+}
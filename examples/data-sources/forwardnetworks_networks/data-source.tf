@@ -0,0 +1,13 @@
+# Look up a network by name instead of hardcoding its ID.
+data "forwardnetworks_networks" "production" {
+  name = "production"
+}
+
+# List the workspace networks of that network.
+data "forwardnetworks_networks" "workspaces" {
+  parent_id = data.forwardnetworks_networks.production.networks[0].id
+}
+
+output "production_network_id" {
+  value = data.forwardnetworks_networks.production.networks[0].id
+}
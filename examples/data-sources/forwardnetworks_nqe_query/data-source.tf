@@ -0,0 +1,16 @@
+# Find the interfaces that are administratively down on the latest processed
+# snapshot of a network.
+data "forwardnetworks_nqe_query" "free_interfaces" {
+  network_id = "159780"
+  query      = <<-EOT
+    foreach d in network.devices
+    foreach i in d.interfaces
+    where i.adminStatus == AdminStatus.DOWN
+    select { device: d.name, interface: i.name }
+  EOT
+  limit      = 100
+}
+
+output "free_interfaces" {
+  value = data.forwardnetworks_nqe_query.free_interfaces.rows
+}
@@ -0,0 +1,16 @@
+# Assert that the application is reachable from the office network before
+# cutting over DNS.
+data "forwardnetworks_path_search" "app" {
+  network_id       = "159780"
+  source           = "10.10.0.15"
+  destination      = "10.20.1.10"
+  protocol         = "tcp"
+  destination_port = "443"
+
+  lifecycle {
+    postcondition {
+      condition     = self.delivered
+      error_message = "The application is not reachable from the office network."
+    }
+  }
+}
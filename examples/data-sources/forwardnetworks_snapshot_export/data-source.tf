@@ -0,0 +1,12 @@
+# Archive the latest processed snapshot of the production network to S3.
+data "forwardnetworks_snapshot_export" "production" {
+  network_id  = "159780"
+  output_path = "${path.module}/archives/production.zip"
+}
+
+resource "aws_s3_object" "production_snapshot" {
+  bucket      = "network-snapshot-archive"
+  key         = "production/${data.forwardnetworks_snapshot_export.production.snapshot_id}.zip"
+  source      = data.forwardnetworks_snapshot_export.production.output_path
+  source_hash = data.forwardnetworks_snapshot_export.production.content_sha256
+}
@@ -0,0 +1,10 @@
+# List the processed snapshots of a network taken in the last week.
+data "forwardnetworks_snapshots" "processed" {
+  network_id    = "159780"
+  state         = "PROCESSED"
+  created_after = timeadd(plantimestamp(), "-168h")
+}
+
+output "latest_processed_snapshot" {
+  value = data.forwardnetworks_snapshots.processed.latest_processed_id
+}
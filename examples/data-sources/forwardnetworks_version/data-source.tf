@@ -1,2 +1,11 @@
-# List the Forward Networks API version.
-data "forwardnetworks_version" "all" {}
\ No newline at end of file
+# List the Forward Networks API version.
+data "forwardnetworks_version" "all" {}
+
+# Fail fast when the Forward Networks API is older than 23.4.
+data "forwardnetworks_version" "supported" {
+  minimum_version = "23.4"
+}
+
+output "forward_deployment_type" {
+  value = data.forwardnetworks_version.supported.deployment_type
+}
@@ -15,7 +15,7 @@ data "fwdnet_external_id" "example" {
 }
 
 output "external_id" {
-  value = data.fwdnet_external_id.example.id
+  value = data.fwdnet_external_id.example.external_id
 }
 
 output "fwdnet_version" {
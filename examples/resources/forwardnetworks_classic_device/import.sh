@@ -0,0 +1,2 @@
+# Classic devices can be imported by specifying the network ID and device name.
+terraform import forwardnetworks_classic_device.core_1 159780/core-1
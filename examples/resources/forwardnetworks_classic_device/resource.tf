@@ -0,0 +1,21 @@
+# Collect a core router, logging in with a managed device credential.
+resource "forwardnetworks_classic_device" "core_1" {
+  network_id         = "159780"
+  name               = "core-1"
+  host               = "10.0.0.1"
+  type               = "cisco_ios_ssh"
+  cli_credential_id  = forwardnetworks_device_credential.core_routers.id
+  snmp_credential_id = forwardnetworks_device_credential.snmp.id
+}
+
+# Reach a lab switch through a jump server on a non-standard port, without
+# collecting it yet.
+resource "forwardnetworks_classic_device" "lab_1" {
+  network_id     = "159780"
+  name           = "lab-1"
+  host           = "lab-1.example.com"
+  port           = 2222
+  type           = "arista_eos_ssh"
+  jump_server_id = "J-1"
+  collect        = false
+}
@@ -0,0 +1,3 @@
+# The classic devices of a network can be imported by specifying the network ID.
+# Every device of the network is then managed by the resource.
+terraform import forwardnetworks_classic_devices.edge 159780
@@ -0,0 +1,19 @@
+locals {
+  edge_switches = {
+    "edge-1" = "10.0.1.1"
+    "edge-2" = "10.0.1.2"
+    "edge-3" = "10.0.1.3"
+  }
+}
+
+# Manage many devices at once; changes are sent to Forward Networks in batches.
+resource "forwardnetworks_classic_devices" "edge" {
+  network_id = "159780"
+  devices = {
+    for name, host in local.edge_switches : name => {
+      host              = host
+      type              = "arista_eos_ssh"
+      cli_credential_id = forwardnetworks_device_credential.edge_switches.id
+    }
+  }
+}
@@ -0,0 +1,4 @@
+# Device credentials can be imported by specifying the network ID and credential ID.
+# Their secrets are never returned by Forward Networks, so the next apply sets
+# them to the configured values.
+terraform import forwardnetworks_device_credential.core_routers 159780/D-1234
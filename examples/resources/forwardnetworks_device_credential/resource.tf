@@ -0,0 +1,32 @@
+variable "router_password" {
+  type      = string
+  sensitive = true
+}
+
+# Log in to the core routers with a username and password. The password is
+# write-only, so increment secret_version to send a rotated password.
+resource "forwardnetworks_device_credential" "core_routers" {
+  network_id     = "159780"
+  type           = "CLI"
+  name           = "core routers"
+  username       = "forward-collector"
+  password       = var.router_password
+  secret_version = 1
+}
+
+# Log in to the edge switches with an SSH key.
+resource "forwardnetworks_device_credential" "edge_switches" {
+  network_id  = "159780"
+  type        = "SSH_KEY"
+  name        = "edge switches"
+  username    = "forward-collector"
+  private_key = file("${path.module}/collector_ed25519")
+}
+
+# Poll devices over SNMP.
+resource "forwardnetworks_device_credential" "snmp" {
+  network_id = "159780"
+  type       = "SNMP"
+  name       = "monitoring"
+  community  = "forward-ro"
+}
@@ -0,0 +1,2 @@
+# Intent checks can be imported by specifying the network ID and check ID.
+terraform import forwardnetworks_intent_check.web_reachable 159780/C-1234
@@ -0,0 +1,35 @@
+# Verify that the web tier is reachable from the office network.
+resource "forwardnetworks_intent_check" "web_reachable" {
+  network_id = "159780"
+  name       = "Office to web tier"
+  priority   = "HIGH"
+  tags       = ["web"]
+
+  reachability {
+    source            = "10.10.0.0/16"
+    destination       = "10.20.1.10"
+    protocol          = "tcp"
+    destination_ports = "443"
+  }
+}
+
+# Verify that the PCI zone is isolated from the guest network.
+resource "forwardnetworks_intent_check" "pci_isolated" {
+  network_id = "159780"
+  name       = "Guest to PCI"
+  tags       = ["pci"]
+
+  isolation {
+    source      = "192.168.100.0/24"
+    destination = "10.50.0.0/16"
+  }
+}
+
+# Verify that an NQE library query returns no violations.
+resource "forwardnetworks_intent_check" "no_default_credentials" {
+  network_id = "159780"
+
+  nqe {
+    query_id = "FQ_0123456789abcdef"
+  }
+}
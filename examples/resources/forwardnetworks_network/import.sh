@@ -0,0 +1,2 @@
+# Networks can be imported by specifying the numeric network identifier.
+terraform import forwardnetworks_network.example 159780
@@ -0,0 +1,13 @@
+# Manage a Forward Networks network.
+resource "forwardnetworks_network" "example" {
+  name = "customer-a"
+  note = "Managed by Terraform"
+}
+
+# Give an application team a workspace network scoped to its devices.
+resource "forwardnetworks_network" "team_a" {
+  name        = "customer-a-team-a"
+  parent_id   = forwardnetworks_network.example.id
+  devices     = ["team-a-edge-1", "team-a-edge-2"]
+  device_tags = ["team-a"]
+}
@@ -0,0 +1,2 @@
+# The external ID of a network can be imported by specifying the network ID.
+terraform import forwardnetworks_network_external_id.aws 159780
@@ -0,0 +1,31 @@
+resource "forwardnetworks_network" "example" {
+  name = "customer-a"
+}
+
+# Rotate the external ID whenever the trusting AWS account changes, and use it
+# in the trust policy of the role Forward Networks assumes to collect the
+# account.
+resource "forwardnetworks_network_external_id" "aws" {
+  network_id = forwardnetworks_network.example.id
+
+  rotation_triggers = {
+    account_id = "123456789012"
+  }
+}
+
+data "aws_iam_policy_document" "forward_trust" {
+  statement {
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      type        = "AWS"
+      identifiers = ["arn:aws:iam::123456789012:root"]
+    }
+
+    condition {
+      test     = "StringEquals"
+      variable = "sts:ExternalId"
+      values   = [forwardnetworks_network_external_id.aws.external_id]
+    }
+  }
+}
@@ -0,0 +1,3 @@
+# NQE queries can be imported by query ID or by their path in the library.
+terraform import forwardnetworks_nqe_query.free_interfaces Q_0123456789abcdef
+terraform import forwardnetworks_nqe_query.free_interfaces "/Org/Interfaces/Free Interfaces"
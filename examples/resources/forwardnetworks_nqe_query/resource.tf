@@ -0,0 +1,8 @@
+# Manage an NQE library query whose source lives next to the configuration.
+resource "forwardnetworks_nqe_query" "free_interfaces" {
+  path           = "/Org/Interfaces/Free Interfaces"
+  source         = file("${path.module}/queries/free_interfaces.nqe")
+  description    = "Interfaces that are administratively down"
+  committed      = true
+  commit_message = "Managed by Terraform"
+}
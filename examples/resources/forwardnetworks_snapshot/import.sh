@@ -0,0 +1,2 @@
+# Snapshots can be imported by specifying the network ID and snapshot ID.
+terraform import forwardnetworks_snapshot.after_change 159780/553412
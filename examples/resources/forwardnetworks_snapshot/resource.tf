@@ -0,0 +1,19 @@
+# Collect a fresh snapshot of the edge routers whenever their configuration
+# changes, then evaluate checks against it.
+resource "forwardnetworks_snapshot" "after_change" {
+  network_id = "159780"
+  devices    = ["edge-1", "edge-2"]
+
+  triggers = {
+    edge_config = sha256(file("${path.module}/edge.cfg"))
+  }
+
+  timeouts = {
+    create = "45m"
+  }
+}
+
+data "forwardnetworks_check_results" "after_change" {
+  network_id  = forwardnetworks_snapshot.after_change.network_id
+  snapshot_id = forwardnetworks_snapshot.after_change.id
+}
@@ -0,0 +1,15 @@
+# Upload a snapshot exported from another Forward Networks instance. A new
+# snapshot is uploaded whenever the archive content changes.
+resource "forwardnetworks_snapshot_import" "lab" {
+  network_id = "159780"
+  source     = "${path.module}/snapshots/lab.zip"
+
+  timeouts = {
+    create = "1h"
+  }
+}
+
+data "forwardnetworks_check_results" "lab" {
+  network_id  = forwardnetworks_snapshot_import.lab.network_id
+  snapshot_id = forwardnetworks_snapshot_import.lab.id
+}
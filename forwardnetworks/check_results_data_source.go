@@ -0,0 +1,242 @@
+package forwardnetworks
+
+import (
+	"context"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// checkStatusPass is the status of a check without violations.
+const checkStatusPass = "PASS"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &checkResultsDataSource{}
+	_ datasource.DataSourceWithConfigure = &checkResultsDataSource{}
+)
+
+// NewCheckResultsDataSource is a helper function to simplify the provider implementation.
+func NewCheckResultsDataSource() datasource.DataSource {
+	return &checkResultsDataSource{}
+}
+
+// checkResultsDataSource is the data source implementation.
+type checkResultsDataSource struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// checkResultsDataSourceModel maps the data source schema data.
+type checkResultsDataSourceModel struct {
+	ID           types.String       `tfsdk:"id"`
+	NetworkID    types.String       `tfsdk:"network_id"`
+	SnapshotID   types.String       `tfsdk:"snapshot_id"`
+	Tags         []types.String     `tfsdk:"tags"`
+	Priority     types.String       `tfsdk:"priority"`
+	StatusCounts map[string]int64   `tfsdk:"status_counts"`
+	AllPassed    types.Bool         `tfsdk:"all_passed"`
+	Checks       []checkResultModel `tfsdk:"checks"`
+}
+
+// checkResultModel maps check result data.
+type checkResultModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	CheckType      types.String   `tfsdk:"check_type"`
+	Priority       types.String   `tfsdk:"priority"`
+	Tags           []types.String `tfsdk:"tags"`
+	Status         types.String   `tfsdk:"status"`
+	ViolationCount types.Int64    `tfsdk:"violation_count"`
+	Violations     []types.String `tfsdk:"violations"`
+}
+
+// Metadata returns the data source type name.
+func (d *checkResultsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_check_results"
+}
+
+// Schema defines the schema for the data source.
+func (d *checkResultsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the results of the intent verification checks of a Forward Networks snapshot.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The network ID whose check results are fetched. Defaults to the default_network_id of the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "The snapshot ID whose check results are fetched. May also be latest or latestProcessed. Defaults to the default_snapshot of the provider, or the latest processed snapshot of the network; once read, holds the snapshot the results belong to.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"tags": schema.ListAttribute{
+				Description: "Only return checks that have at least one of these tags.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"priority": schema.StringAttribute{
+				Description: "Only return checks with this priority, such as HIGH.",
+				Optional:    true,
+			},
+			"status_counts": schema.MapAttribute{
+				Description: "The number of returned checks by status, such as PASS or FAIL.",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"all_passed": schema.BoolAttribute{
+				Description: "Whether every returned check has the PASS status.",
+				Computed:    true,
+			},
+			"checks": schema.ListNestedAttribute{
+				Description: "The results of the checks matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the check.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the check.",
+							Computed:    true,
+						},
+						"check_type": schema.StringAttribute{
+							Description: "Type of the check, such as Reachability, Isolation, Existential or NQE.",
+							Computed:    true,
+						},
+						"priority": schema.StringAttribute{
+							Description: "Priority of the check.",
+							Computed:    true,
+						},
+						"tags": schema.ListAttribute{
+							Description: "Tags of the check.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"status": schema.StringAttribute{
+							Description: "Status of the check, such as PASS, FAIL or ERROR.",
+							Computed:    true,
+						},
+						"violation_count": schema.Int64Attribute{
+							Description: "The number of violations of the check.",
+							Computed:    true,
+						},
+						"violations": schema.ListAttribute{
+							Description: "Descriptions of the violations of the check.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *checkResultsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.defaults = data.defaults
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *checkResultsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state checkResultsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.NetworkID = d.defaults.resolveNetworkID(state.NetworkID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	snapshotID := d.defaults.resolveSnapshotID(d.client, state.NetworkID.ValueString(), state.SnapshotID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := d.client.GetCheckResults(state.NetworkID.ValueString(), snapshotID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Forward Networks Check Results",
+			"Could not read check results of network ID "+state.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	wantTags := map[string]bool{}
+	for _, tag := range state.Tags {
+		wantTags[tag.ValueString()] = true
+	}
+
+	state.Checks = []checkResultModel{}
+	state.StatusCounts = map[string]int64{}
+	state.AllPassed = types.BoolValue(true)
+	for _, result := range results.Checks {
+		if !state.Priority.IsNull() && result.Priority != state.Priority.ValueString() {
+			continue
+		}
+		if len(wantTags) > 0 && !hasAnyTag(result.Tags, wantTags) {
+			continue
+		}
+
+		check := checkResultModel{
+			ID:             types.StringValue(result.ID),
+			Name:           types.StringValue(result.Name),
+			CheckType:      types.StringValue(result.CheckType),
+			Priority:       types.StringValue(result.Priority),
+			Tags:           []types.String{},
+			Status:         types.StringValue(result.Status),
+			ViolationCount: types.Int64Value(int64(result.NumViolations)),
+			Violations:     []types.String{},
+		}
+		for _, tag := range result.Tags {
+			check.Tags = append(check.Tags, types.StringValue(tag))
+		}
+		for _, violation := range result.Violations {
+			check.Violations = append(check.Violations, types.StringValue(violation))
+		}
+		state.Checks = append(state.Checks, check)
+
+		state.StatusCounts[result.Status]++
+		if result.Status != checkStatusPass {
+			state.AllPassed = types.BoolValue(false)
+		}
+	}
+
+	state.SnapshotID = types.StringValue(results.SnapshotID)
+	state.ID = types.StringValue(results.SnapshotID)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// hasAnyTag reports whether any of tags is in want.
+func hasAnyTag(tags []string, want map[string]bool) bool {
+	for _, tag := range tags {
+		if want[tag] {
+			return true
+		}
+	}
+	return false
+}
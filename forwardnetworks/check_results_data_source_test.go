@@ -0,0 +1,75 @@
+package forwardnetworks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCheckResultsDataSource(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("check-results")
+	snapshotID := srv.addSnapshot(networkID, snapshotStateProcessed, time.Now())
+	srv.addCheck(networkID, forwardnetworks.Check{
+		Name:      "web reachable",
+		CheckType: forwardnetworks.CheckTypeReachability,
+		Priority:  "HIGH",
+		Tags:      []string{"web"},
+	})
+	srv.addCheck(networkID, forwardnetworks.Check{
+		Name:      "pci isolated",
+		CheckType: forwardnetworks.CheckTypeIsolation,
+		Priority:  "HIGH",
+		Tags:      []string{"pci"},
+	})
+	srv.addCheck(networkID, forwardnetworks.Check{
+		Name:      "no telnet",
+		CheckType: forwardnetworks.CheckTypeNqe,
+		Priority:  "LOW",
+	})
+	srv.setCheckViolations(networkID, "pci isolated", []string{"10.0.0.5 -> 10.50.0.1 delivered"})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_check_results" "test" {
+  network_id = "` + networkID + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "snapshot_id", snapshotID),
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "checks.#", "3"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "status_counts.PASS", "2"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "status_counts.FAIL", "1"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "all_passed", "false"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "checks.1.name", "pci isolated"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "checks.1.violation_count", "1"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "checks.1.violations.0", "10.0.0.5 -> 10.50.0.1 delivered"),
+				),
+			},
+			// Filter testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_check_results" "test" {
+  network_id  = "` + networkID + `"
+  snapshot_id = "` + snapshotID + `"
+  priority    = "HIGH"
+  tags        = ["web"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "checks.#", "1"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "checks.0.name", "web reachable"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "checks.0.tags.0", "web"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "status_counts.PASS", "1"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_check_results.test", "all_passed", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,400 @@
+package forwardnetworks
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultClassicDevicePort is the port Forward Networks connects to when a
+// classic device omits it.
+const defaultClassicDevicePort = 22
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &classicDeviceResource{}
+	_ resource.ResourceWithConfigure      = &classicDeviceResource{}
+	_ resource.ResourceWithModifyPlan     = &classicDeviceResource{}
+	_ resource.ResourceWithImportState    = &classicDeviceResource{}
+	_ resource.ResourceWithValidateConfig = &classicDeviceResource{}
+)
+
+// NewClassicDeviceResource is a helper function to simplify the provider implementation.
+func NewClassicDeviceResource() resource.Resource {
+	return &classicDeviceResource{}
+}
+
+// classicDeviceResource is the resource implementation.
+type classicDeviceResource struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// classicDeviceResourceModel maps the resource schema data.
+type classicDeviceResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	NetworkID        types.String `tfsdk:"network_id"`
+	Name             types.String `tfsdk:"name"`
+	Host             types.String `tfsdk:"host"`
+	Port             types.Int64  `tfsdk:"port"`
+	Type             types.String `tfsdk:"type"`
+	CliCredentialID  types.String `tfsdk:"cli_credential_id"`
+	SnmpCredentialID types.String `tfsdk:"snmp_credential_id"`
+	JumpServerID     types.String `tfsdk:"jump_server_id"`
+	Collect          types.Bool   `tfsdk:"collect"`
+}
+
+// classicDeviceModel maps the settings of a classic device, shared by the
+// classic_device and classic_devices resources.
+type classicDeviceModel struct {
+	Host             types.String `tfsdk:"host"`
+	Port             types.Int64  `tfsdk:"port"`
+	Type             types.String `tfsdk:"type"`
+	CliCredentialID  types.String `tfsdk:"cli_credential_id"`
+	SnmpCredentialID types.String `tfsdk:"snmp_credential_id"`
+	JumpServerID     types.String `tfsdk:"jump_server_id"`
+	Collect          types.Bool   `tfsdk:"collect"`
+}
+
+// Metadata returns the resource type name.
+func (r *classicDeviceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_classic_device"
+}
+
+// Schema defines the schema for the resource.
+func (r *classicDeviceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := classicDeviceSchemaAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Description: "Identifier of the device, of the form network_id/name.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["network_id"] = schema.StringAttribute{
+		Description: "The network ID the device is collected by. Defaults to the default_network_id of the provider.",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "Name of the device, unique within the network. Changing it replaces the device.",
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a classic device source of a Forward Networks network, collected over SSH or Telnet. " +
+			"Use forwardnetworks_classic_devices to manage many devices with batched requests.",
+		Attributes: attributes,
+	}
+}
+
+// classicDeviceSchemaAttributes returns the attributes of the settings of a
+// classic device.
+func classicDeviceSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"host": schema.StringAttribute{
+			Description: "Hostname or IP address Forward Networks connects to.",
+			Required:    true,
+		},
+		"port": schema.Int64Attribute{
+			Description: "TCP port Forward Networks connects to. Defaults to 22.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(defaultClassicDevicePort),
+		},
+		"type": schema.StringAttribute{
+			Description: "Type of the device, such as cisco_ios_ssh or juniper_junos_ssh, which selects how it is collected.",
+			Required:    true,
+		},
+		"cli_credential_id": schema.StringAttribute{
+			Description: "ID of the device credential used to log in to the device. When omitted, the credentials of the network are tried.",
+			Optional:    true,
+		},
+		"snmp_credential_id": schema.StringAttribute{
+			Description: "ID of the SNMP device credential used to poll the device.",
+			Optional:    true,
+		},
+		"jump_server_id": schema.StringAttribute{
+			Description: "ID of the jump server used to reach the device.",
+			Optional:    true,
+		},
+		"collect": schema.BoolAttribute{
+			Description: "Whether the device is collected. Defaults to true.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+		},
+	}
+}
+
+// ValidateConfig ensures the port is valid.
+func (r *classicDeviceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config classicDeviceResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.settings().validate(path.Empty(), &resp.Diagnostics)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *classicDeviceResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.defaults = data.defaults
+}
+
+// ModifyPlan fills in the network ID from the default_network_id of the
+// provider when it is omitted, and replaces the device when its network
+// changes.
+func (r *classicDeviceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the device is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.defaults.planNetworkID(ctx, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *classicDeviceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan classicDeviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID, name := plan.NetworkID.ValueString(), plan.Name.ValueString()
+
+	// Adding a device replaces a device with the same name, so refuse to
+	// take over a device that is not managed by Terraform.
+	_, err := r.client.GetClassicDevice(networkID, name)
+	if err == nil {
+		resp.Diagnostics.AddError(
+			"Classic Device Already Exists",
+			"Network ID "+networkID+" already has a device named "+name+". Import it to manage it with Terraform.",
+		)
+		return
+	}
+	if !errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Classic Device",
+			"Could not read device "+name+" of network ID "+networkID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Add new device
+	err = r.client.AddClassicDevices(networkID, []forwardnetworks.ClassicDevice{plan.settings().device(name)})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Classic Device",
+			"Could not add device "+name+" to network ID "+networkID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(networkID + "/" + name)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *classicDeviceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state classicDeviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed device value from Forward Networks
+	device, err := r.client.GetClassicDevice(state.NetworkID.ValueString(), state.Name.ValueString())
+	if errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Forward Networks Classic Device",
+			"Could not read device "+state.Name.ValueString()+" of network ID "+state.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite attributes with refreshed state
+	state.ID = types.StringValue(state.NetworkID.ValueString() + "/" + device.Name)
+	state.Name = types.StringValue(device.Name)
+	state.setSettings(newClassicDeviceModel(device))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *classicDeviceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan classicDeviceResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replace the existing device
+	err := r.client.AddClassicDevices(plan.NetworkID.ValueString(), []forwardnetworks.ClassicDevice{plan.settings().device(plan.Name.ValueString())})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Forward Networks Classic Device",
+			"Could not update device "+plan.Name.ValueString()+" of network ID "+plan.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *classicDeviceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state classicDeviceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing device
+	err := r.client.DeleteClassicDevice(state.NetworkID.ValueString(), state.Name.ValueString())
+	if err != nil && !errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Forward Networks Classic Device",
+			"Could not delete device "+state.Name.ValueString()+" of network ID "+state.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing device using an import ID of the form
+// network_id/name.
+func (r *classicDeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	networkID, name, ok := strings.Cut(req.ID, "/")
+	if !ok || networkID == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format network_id/name, got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), networkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// settings returns the settings of the device.
+func (m *classicDeviceResourceModel) settings() classicDeviceModel {
+	return classicDeviceModel{
+		Host:             m.Host,
+		Port:             m.Port,
+		Type:             m.Type,
+		CliCredentialID:  m.CliCredentialID,
+		SnmpCredentialID: m.SnmpCredentialID,
+		JumpServerID:     m.JumpServerID,
+		Collect:          m.Collect,
+	}
+}
+
+// setSettings updates the settings of the device.
+func (m *classicDeviceResourceModel) setSettings(settings classicDeviceModel) {
+	m.Host = settings.Host
+	m.Port = settings.Port
+	m.Type = settings.Type
+	m.CliCredentialID = settings.CliCredentialID
+	m.SnmpCredentialID = settings.SnmpCredentialID
+	m.JumpServerID = settings.JumpServerID
+	m.Collect = settings.Collect
+}
+
+// validate adds an attribute error relative to p when the port is invalid.
+func (m classicDeviceModel) validate(p path.Path, diags *diag.Diagnostics) {
+	if m.Port.IsNull() || m.Port.IsUnknown() {
+		return
+	}
+	if port := m.Port.ValueInt64(); port < 1 || port > 65535 {
+		diags.AddAttributeError(
+			p.AtName("port"),
+			"Invalid Port",
+			"The port must be between 1 and 65535, got: "+strconv.FormatInt(port, 10),
+		)
+	}
+}
+
+// device builds the API representation of a device with the given name.
+func (m classicDeviceModel) device(name string) forwardnetworks.ClassicDevice {
+	return forwardnetworks.ClassicDevice{
+		Name:             name,
+		Host:             m.Host.ValueString(),
+		Port:             int(m.Port.ValueInt64()),
+		Type:             m.Type.ValueString(),
+		CliCredentialID:  m.CliCredentialID.ValueString(),
+		SnmpCredentialID: m.SnmpCredentialID.ValueString(),
+		JumpServerID:     m.JumpServerID.ValueString(),
+		Collect:          m.Collect.ValueBool(),
+	}
+}
+
+// newClassicDeviceModel returns the settings of a device returned by the API.
+func newClassicDeviceModel(device *forwardnetworks.ClassicDevice) classicDeviceModel {
+	port := device.Port
+	if port == 0 {
+		port = defaultClassicDevicePort
+	}
+	return classicDeviceModel{
+		Host:             types.StringValue(device.Host),
+		Port:             types.Int64Value(int64(port)),
+		Type:             types.StringValue(device.Type),
+		CliCredentialID:  stringValueOrNull(device.CliCredentialID),
+		SnmpCredentialID: stringValueOrNull(device.SnmpCredentialID),
+		JumpServerID:     stringValueOrNull(device.JumpServerID),
+		Collect:          types.BoolValue(device.Collect),
+	}
+}
@@ -0,0 +1,120 @@
+package forwardnetworks
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccClassicDeviceResource(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("devices")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_classic_device" "test" {
+  network_id = "` + networkID + `"
+  name       = "core-1"
+  host       = "10.0.0.1"
+  type       = "cisco_ios_ssh"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_classic_device.test", "id", networkID+"/core-1"),
+					resource.TestCheckResourceAttr("forwardnetworks_classic_device.test", "port", "22"),
+					resource.TestCheckResourceAttr("forwardnetworks_classic_device.test", "collect", "true"),
+					resource.TestCheckNoResourceAttr("forwardnetworks_classic_device.test", "cli_credential_id"),
+					testAccCheckClassicDeviceHost(srv, networkID, "core-1", "10.0.0.1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "forwardnetworks_classic_device.test",
+				ImportState:       true,
+				ImportStateId:     networkID + "/core-1",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_classic_device" "test" {
+  network_id         = "` + networkID + `"
+  name               = "core-1"
+  host               = "core-1.example.com"
+  port               = 2222
+  type               = "cisco_ios_ssh"
+  cli_credential_id  = "D-1"
+  snmp_credential_id = "D-2"
+  jump_server_id     = "J-1"
+  collect            = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_classic_device.test", "port", "2222"),
+					resource.TestCheckResourceAttr("forwardnetworks_classic_device.test", "cli_credential_id", "D-1"),
+					resource.TestCheckResourceAttr("forwardnetworks_classic_device.test", "snmp_credential_id", "D-2"),
+					resource.TestCheckResourceAttr("forwardnetworks_classic_device.test", "jump_server_id", "J-1"),
+					resource.TestCheckResourceAttr("forwardnetworks_classic_device.test", "collect", "false"),
+					testAccCheckClassicDeviceHost(srv, networkID, "core-1", "core-1.example.com"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if srv.classicDevice(networkID, "core-1") != nil {
+				return fmt.Errorf("device core-1 still exists")
+			}
+			return nil
+		},
+	})
+}
+
+func TestAccClassicDeviceResource_alreadyExists(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("devices")
+	srv.addClassicDevice(networkID, forwardnetworks.ClassicDevice{Name: "core-1", Host: "10.0.0.1", Type: "cisco_ios_ssh", Collect: true})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_classic_device" "test" {
+  network_id = "` + networkID + `"
+  name       = "core-1"
+  host       = "10.0.0.2"
+  type       = "cisco_ios_ssh"
+}
+`,
+				ExpectError: regexp.MustCompile("already has a device named core-1"),
+			},
+		},
+	})
+
+	if device := srv.classicDevice(networkID, "core-1"); device == nil || device.Host != "10.0.0.1" {
+		t.Errorf("expected the existing device to be left unchanged, got %+v", device)
+	}
+}
+
+// testAccCheckClassicDeviceHost verifies the device stored by the fake server
+// has the expected host.
+func testAccCheckClassicDeviceHost(srv *fakeServer, networkID, name, host string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		device := srv.classicDevice(networkID, name)
+		if device == nil {
+			return fmt.Errorf("device %s does not exist", name)
+		}
+		if device.Host != host {
+			return fmt.Errorf("expected host %q for device %s, got %q", host, name, device.Host)
+		}
+		return nil
+	}
+}
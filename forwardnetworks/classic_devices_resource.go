@@ -0,0 +1,366 @@
+package forwardnetworks
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// classicDeviceBatchSize is the maximum number of devices added or deleted in
+// a single request.
+var classicDeviceBatchSize = 500
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &classicDevicesResource{}
+	_ resource.ResourceWithConfigure      = &classicDevicesResource{}
+	_ resource.ResourceWithModifyPlan     = &classicDevicesResource{}
+	_ resource.ResourceWithImportState    = &classicDevicesResource{}
+	_ resource.ResourceWithValidateConfig = &classicDevicesResource{}
+)
+
+// NewClassicDevicesResource is a helper function to simplify the provider implementation.
+func NewClassicDevicesResource() resource.Resource {
+	return &classicDevicesResource{}
+}
+
+// classicDevicesResource is the resource implementation.
+type classicDevicesResource struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// classicDevicesResourceModel maps the resource schema data.
+type classicDevicesResourceModel struct {
+	ID        types.String                  `tfsdk:"id"`
+	NetworkID types.String                  `tfsdk:"network_id"`
+	Devices   map[string]classicDeviceModel `tfsdk:"devices"`
+}
+
+// Metadata returns the resource type name.
+func (r *classicDevicesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_classic_devices"
+}
+
+// Schema defines the schema for the resource.
+func (r *classicDevicesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of classic device sources of a Forward Networks network. " +
+			"Devices are added, updated and deleted with batched requests, and devices of the network missing from devices are left unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The network ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The network ID the devices are collected by. Defaults to the default_network_id of the provider. Changing it replaces the devices.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"devices": schema.MapNestedAttribute{
+				Description: "The devices, by name.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: classicDeviceSchemaAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the ports of the devices are valid.
+func (r *classicDevicesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var devices types.Map
+
+	diags := req.Config.GetAttribute(ctx, path.Root("devices"), &devices)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || devices.IsNull() || devices.IsUnknown() {
+		return
+	}
+
+	// Devices are validated once they are known.
+	for _, element := range devices.Elements() {
+		if element.IsUnknown() {
+			return
+		}
+	}
+
+	var settings map[string]classicDeviceModel
+	resp.Diagnostics.Append(devices.ElementsAs(ctx, &settings, false)...)
+	names := make([]string, 0, len(settings))
+	for name := range settings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		settings[name].validate(path.Root("devices").AtMapKey(name), &resp.Diagnostics)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *classicDevicesResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.defaults = data.defaults
+}
+
+// ModifyPlan fills in the network ID from the default_network_id of the
+// provider when it is omitted, and replaces the devices when their network
+// changes.
+func (r *classicDevicesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the devices are destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.defaults.planNetworkID(ctx, req, resp)
+}
+
+// Create adds the devices in batches and sets the initial Terraform state.
+func (r *classicDevicesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan classicDevicesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := plan.NetworkID.ValueString()
+
+	// Adding a device replaces a device with the same name, so refuse to
+	// take over devices that are not managed by Terraform.
+	existing, err := r.client.GetClassicDevices(networkID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Classic Devices",
+			"Could not read the devices of network ID "+networkID+": "+err.Error(),
+		)
+		return
+	}
+	var conflicts []string
+	for _, device := range existing {
+		if _, ok := plan.Devices[device.Name]; ok {
+			conflicts = append(conflicts, device.Name)
+		}
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		resp.Diagnostics.AddError(
+			"Classic Devices Already Exist",
+			"Network ID "+networkID+" already has devices named "+strings.Join(conflicts, ", ")+". "+
+				"Remove them from devices, or import the network ID to manage all of its devices with Terraform.",
+		)
+		return
+	}
+
+	// The devices added before a failed batch are saved, so that they are
+	// tainted and replaced by the next apply.
+	added, err := r.addDevices(networkID, plan.Devices)
+
+	plan.ID = types.StringValue(networkID)
+	plan.Devices = added
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Classic Devices",
+			"Could not add devices to network ID "+networkID+": "+err.Error(),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data. Only the devices
+// in the state are refreshed, except after an import, where every device of
+// the network is read.
+func (r *classicDevicesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state classicDevicesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed devices from Forward Networks
+	devices, err := r.client.GetClassicDevices(state.NetworkID.ValueString())
+	if errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Forward Networks Classic Devices",
+			"Could not read the devices of network ID "+state.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite devices with refreshed state
+	imported := state.Devices == nil
+	refreshed := map[string]classicDeviceModel{}
+	for i := range devices {
+		device := &devices[i]
+		if _, ok := state.Devices[device.Name]; ok || imported {
+			refreshed[device.Name] = newClassicDeviceModel(device)
+		}
+	}
+	state.ID = types.StringValue(state.NetworkID.ValueString())
+	state.Devices = refreshed
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update adds the new and changed devices and deletes the removed devices in
+// batches, then sets the updated Terraform state. Adding and deleting devices
+// can be repeated, so a failed update is completed by the next apply.
+func (r *classicDevicesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state classicDevicesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := plan.NetworkID.ValueString()
+
+	changed := map[string]classicDeviceModel{}
+	for name, device := range plan.Devices {
+		if current, ok := state.Devices[name]; !ok || current != device {
+			changed[name] = device
+		}
+	}
+	var removed []string
+	for name := range state.Devices {
+		if _, ok := plan.Devices[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	if _, err := r.addDevices(networkID, changed); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Forward Networks Classic Devices",
+			"Could not add devices to network ID "+networkID+": "+err.Error(),
+		)
+		return
+	}
+	if err := r.deleteDevices(networkID, removed); err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Forward Networks Classic Devices",
+			"Could not delete devices of network ID "+networkID+": "+err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the devices in batches and removes the Terraform state on
+// success.
+func (r *classicDevicesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state classicDevicesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var names []string
+	for name := range state.Devices {
+		names = append(names, name)
+	}
+
+	// Delete existing devices
+	err := r.deleteDevices(state.NetworkID.ValueString(), names)
+	if err != nil && !errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Forward Networks Classic Devices",
+			"Could not delete devices of network ID "+state.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports every device of a network using the network ID as the
+// import ID.
+func (r *classicDevicesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// addDevices adds devices to a network in batches of classicDeviceBatchSize,
+// in name order. It returns the devices that were added, which are all of
+// them unless an error is returned.
+func (r *classicDevicesResource) addDevices(networkID string, devices map[string]classicDeviceModel) (map[string]classicDeviceModel, error) {
+	names := make([]string, 0, len(devices))
+	for name := range devices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	added := map[string]classicDeviceModel{}
+	for start := 0; start < len(names); start += classicDeviceBatchSize {
+		end := start + classicDeviceBatchSize
+		if end > len(names) {
+			end = len(names)
+		}
+
+		batch := make([]forwardnetworks.ClassicDevice, 0, end-start)
+		for _, name := range names[start:end] {
+			batch = append(batch, devices[name].device(name))
+		}
+		if err := r.client.AddClassicDevices(networkID, batch); err != nil {
+			return added, err
+		}
+		for _, name := range names[start:end] {
+			added[name] = devices[name]
+		}
+	}
+	return added, nil
+}
+
+// deleteDevices deletes devices of a network in batches of
+// classicDeviceBatchSize, in name order.
+func (r *classicDevicesResource) deleteDevices(networkID string, names []string) error {
+	sort.Strings(names)
+
+	for start := 0; start < len(names); start += classicDeviceBatchSize {
+		end := start + classicDeviceBatchSize
+		if end > len(names) {
+			end = len(names)
+		}
+
+		if err := r.client.DeleteClassicDevices(networkID, names[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
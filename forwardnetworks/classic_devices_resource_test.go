@@ -0,0 +1,159 @@
+package forwardnetworks
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccClassicDevicesResource(t *testing.T) {
+	setClassicDeviceBatchSize(t, 2)
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("devices")
+	// Devices not in the configuration are left unchanged.
+	srv.addClassicDevice(networkID, forwardnetworks.ClassicDevice{Name: "unmanaged", Host: "10.0.1.1", Type: "cisco_ios_ssh", Collect: true})
+
+	var batches int
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: srv.providerConfig() + testAccClassicDevicesConfig(networkID, 1, 5),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_classic_devices.test", "id", networkID),
+					resource.TestCheckResourceAttr("forwardnetworks_classic_devices.test", "devices.%", "5"),
+					resource.TestCheckResourceAttr("forwardnetworks_classic_devices.test", "devices.switch-1.host", "10.0.0.1"),
+					resource.TestCheckResourceAttr("forwardnetworks_classic_devices.test", "devices.switch-1.port", "22"),
+					resource.TestCheckResourceAttr("forwardnetworks_classic_devices.test", "devices.switch-1.collect", "true"),
+					testAccCheckClassicDeviceCount(srv, networkID, 6),
+					func(_ *terraform.State) error {
+						batches = srv.classicDeviceBatches()
+						if batches != 3 {
+							return fmt.Errorf("expected 5 devices to be added in 3 batches, got %d", batches)
+						}
+						return nil
+					},
+				),
+			},
+			// Update and Read testing
+			{
+				Config: srv.providerConfig() + testAccClassicDevicesConfig(networkID, 3, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_classic_devices.test", "devices.%", "5"),
+					resource.TestCheckNoResourceAttr("forwardnetworks_classic_devices.test", "devices.switch-1.host"),
+					resource.TestCheckResourceAttr("forwardnetworks_classic_devices.test", "devices.switch-7.host", "10.0.0.7"),
+					testAccCheckClassicDeviceCount(srv, networkID, 6),
+					testAccCheckClassicDeviceHost(srv, networkID, "unmanaged", "10.0.1.1"),
+					func(_ *terraform.State) error {
+						// switch-6 and switch-7 are added in one batch, and
+						// switch-1 and switch-2 are deleted in another.
+						if got := srv.classicDeviceBatches() - batches; got != 2 {
+							return fmt.Errorf("expected 2 batches, got %d", got)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "forwardnetworks_classic_devices.test",
+				ImportState:       true,
+				ImportStateId:     networkID,
+				ImportStateVerify: true,
+				// The import manages every device of the network.
+				ImportStateVerifyIgnore: []string{"devices.%", "devices.unmanaged"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+		CheckDestroy: testAccCheckClassicDeviceCount(srv, networkID, 1),
+	})
+}
+
+func TestAccClassicDevicesResource_alreadyExists(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("devices")
+	srv.addClassicDevice(networkID, forwardnetworks.ClassicDevice{Name: "switch-2", Host: "10.0.1.2", Type: "cisco_ios_ssh", Collect: true})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      srv.providerConfig() + testAccClassicDevicesConfig(networkID, 1, 3),
+				ExpectError: regexp.MustCompile("already has devices named switch-2"),
+			},
+		},
+	})
+}
+
+func TestAccClassicDevicesResource_invalidPort(t *testing.T) {
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_classic_devices" "test" {
+  network_id = "1"
+  devices = {
+    "switch-1" = {
+      host = "10.0.0.1"
+      port = 70000
+      type = "arista_eos_ssh"
+    }
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("The port must be between 1 and 65535"),
+			},
+		},
+	})
+}
+
+// testAccClassicDevicesConfig returns a forwardnetworks_classic_devices
+// resource with the devices switch-first to switch-last.
+func testAccClassicDevicesConfig(networkID string, first, last int) string {
+	var devices strings.Builder
+	for i := first; i <= last; i++ {
+		fmt.Fprintf(&devices, `
+    "switch-%d" = {
+      host = "10.0.0.%d"
+      type = "arista_eos_ssh"
+    }`, i, i)
+	}
+
+	return `
+resource "forwardnetworks_classic_devices" "test" {
+  network_id = "` + networkID + `"
+  devices = {` + devices.String() + `
+  }
+}
+`
+}
+
+// testAccCheckClassicDeviceCount verifies the number of devices of a network
+// stored by the fake server.
+func testAccCheckClassicDeviceCount(srv *fakeServer, networkID string, count int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if got := srv.classicDeviceCount(networkID); got != count {
+			return fmt.Errorf("expected %d devices, got %d", count, got)
+		}
+		return nil
+	}
+}
+
+// setClassicDeviceBatchSize changes how many devices are sent in a batch for
+// the duration of a test.
+func setClassicDeviceBatchSize(t *testing.T, size int) {
+	t.Helper()
+
+	previous := classicDeviceBatchSize
+	classicDeviceBatchSize = size
+	t.Cleanup(func() { classicDeviceBatchSize = previous })
+}
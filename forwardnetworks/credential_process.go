@@ -0,0 +1,144 @@
+package forwardnetworks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// credentialExpiryWindow is how long before their expiration credentials
+// from a credential process are refreshed, so that they do not expire while
+// a request is in flight.
+const credentialExpiryWindow = time.Minute
+
+// credentialProcessTimeout bounds how long a credential process may run
+// before it is killed.
+var credentialProcessTimeout = time.Minute
+
+// processCredentials are the credentials printed by a credential process as
+// a JSON object, for example:
+//
+//	{"api_token": "...", "expiration": "2023-05-01T12:00:00Z"}
+//
+// or
+//
+//	{"username": "admin", "password": "..."}
+//
+// Credentials without an expiration never expire.
+type processCredentials struct {
+	Username   string     `json:"username"`
+	Password   string     `json:"password"`
+	APIToken   string     `json:"api_token"`
+	Expiration *time.Time `json:"expiration"`
+}
+
+// credentialProcess obtains Forward Networks API credentials by running an
+// external command, caching them until they expire.
+type credentialProcess struct {
+	command string
+
+	mu          sync.Mutex
+	credentials *processCredentials
+}
+
+// retrieve returns the cached credentials, running the command again when
+// there are none yet or they are about to expire.
+func (p *credentialProcess) retrieve(ctx context.Context) (*processCredentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.credentials != nil && (p.credentials.Expiration == nil || time.Until(*p.credentials.Expiration) > credentialExpiryWindow) {
+		return p.credentials, nil
+	}
+
+	credentials, err := p.run(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.credentials = credentials
+	return credentials, nil
+}
+
+// run runs the command through the shell and parses the credentials it
+// prints.
+func (p *credentialProcess) run(ctx context.Context) (*processCredentials, error) {
+	ctx, cancel := context.WithTimeout(ctx, credentialProcessTimeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", p.command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", p.command)
+	}
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Processes started by the command may keep its output open after it
+	// has been killed, so they are not waited for.
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return nil, fmt.Errorf("credential process did not finish within %s", credentialProcessTimeout)
+		}
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("credential process failed: %w: %s", err, message)
+		}
+		return nil, fmt.Errorf("credential process failed: %w", err)
+	}
+
+	var credentials processCredentials
+	if err := json.Unmarshal(stdout.Bytes(), &credentials); err != nil {
+		return nil, fmt.Errorf("credential process output is not a valid JSON object: %w", err)
+	}
+
+	hasToken := credentials.APIToken != ""
+	hasUser := credentials.Username != "" || credentials.Password != ""
+	switch {
+	case hasToken && hasUser:
+		return nil, errors.New("credential process returned both an API token and a username or password")
+	case hasUser && (credentials.Username == "" || credentials.Password == ""):
+		return nil, errors.New("credential process must return both a username and a password")
+	case !hasToken && !hasUser:
+		return nil, errors.New("credential process returned neither an API token nor a username and password")
+	}
+	if credentials.Expiration != nil && time.Until(*credentials.Expiration) <= 0 {
+		return nil, errors.New("credential process returned credentials that expired at " + credentials.Expiration.Format(time.RFC3339))
+	}
+
+	return &credentials, nil
+}
+
+// credentialProcessTransport is an http.RoundTripper that authenticates every
+// request with the credentials of a credential process.
+type credentialProcessTransport struct {
+	process *credentialProcess
+	next    http.RoundTripper
+}
+
+// RoundTrip sets the Authorization header and forwards the request to the
+// wrapped transport.
+func (t *credentialProcessTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	credentials, err := t.process.retrieve(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.Clone(req.Context())
+	if credentials.APIToken != "" {
+		req.Header.Set("Authorization", "Bearer "+credentials.APIToken)
+	} else {
+		req.SetBasicAuth(credentials.Username, credentials.Password)
+	}
+
+	return roundTripper(t.next).RoundTrip(req)
+}
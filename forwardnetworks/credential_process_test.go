@@ -0,0 +1,158 @@
+package forwardnetworks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCredentialProcess_refreshesExpiredCredentials(t *testing.T) {
+	expiration := time.Now().Add(30 * time.Second).UTC().Format(time.RFC3339)
+	process, invocations := testCredentialProcess(t, fmt.Sprintf(`{"api_token": "token-$n", "expiration": %q}`, expiration))
+
+	for i := 0; i < 2; i++ {
+		credentials, err := process.retrieve(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		// The credentials expire within the expiry window, so the
+		// process runs again for every retrieval.
+		if want := fmt.Sprintf("token-%d", i+1); credentials.APIToken != want {
+			t.Errorf("expected API token %q, got %q", want, credentials.APIToken)
+		}
+	}
+	if n := invocations(); n != 2 {
+		t.Errorf("expected 2 invocations, got %d", n)
+	}
+}
+
+func TestCredentialProcess_cachesCredentials(t *testing.T) {
+	process, invocations := testCredentialProcess(t, `{"username": "user-$n", "password": "secret"}`)
+
+	for i := 0; i < 2; i++ {
+		credentials, err := process.retrieve(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if credentials.Username != "user-1" || credentials.Password != "secret" {
+			t.Errorf("unexpected credentials %+v", credentials)
+		}
+	}
+	if n := invocations(); n != 1 {
+		t.Errorf("expected 1 invocation, got %d", n)
+	}
+}
+
+func TestCredentialProcess_invalidOutput(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential process tests use a POSIX shell")
+	}
+
+	for name, command := range map[string]string{
+		"failing command":  "echo 'access denied' >&2; exit 1",
+		"invalid json":     "echo not json",
+		"no credentials":   "echo '{}'",
+		"both credentials": `echo '{"api_token": "token", "username": "user", "password": "secret"}'`,
+		"missing password": `echo '{"username": "user"}'`,
+		"expired":          `echo '{"api_token": "token", "expiration": "2000-01-01T00:00:00Z"}'`,
+	} {
+		process := &credentialProcess{command: command}
+		if _, err := process.retrieve(context.Background()); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+
+	process := &credentialProcess{command: "echo 'access denied' >&2; exit 1"}
+	if _, err := process.retrieve(context.Background()); err == nil || !strings.Contains(err.Error(), "access denied") {
+		t.Errorf("expected the error to include the command output, got %v", err)
+	}
+}
+
+func TestCredentialProcess_timeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("credential process tests use a POSIX shell")
+	}
+	previous := credentialProcessTimeout
+	credentialProcessTimeout = 100 * time.Millisecond
+	t.Cleanup(func() { credentialProcessTimeout = previous })
+
+	process := &credentialProcess{command: `sleep 10; echo '{"api_token": "token"}'`}
+	start := time.Now()
+	_, err := process.retrieve(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "did not finish within") {
+		t.Errorf("expected a timeout error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the process to be killed, took %s", elapsed)
+	}
+}
+
+func TestCredentialProcessTransport(t *testing.T) {
+	var authorization []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = append(authorization, r.Header.Get("Authorization"))
+	}))
+	defer srv.Close()
+
+	expiration := time.Now().Add(30 * time.Second).UTC().Format(time.RFC3339)
+	process, _ := testCredentialProcess(t, fmt.Sprintf(`{"api_token": "token-$n", "expiration": %q}`, expiration))
+	client := &http.Client{Transport: &credentialProcessTransport{process: process}}
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	if len(authorization) != 2 || authorization[0] != "Bearer token-1" || authorization[1] != "Bearer token-2" {
+		t.Errorf("unexpected Authorization headers %q", authorization)
+	}
+}
+
+// testCredentialProcess returns a credential process printing output, in
+// which $n is replaced by the number of the invocation, and a function
+// returning the number of invocations so far.
+func testCredentialProcess(t *testing.T, output string) (*credentialProcess, func() int) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("credential process tests use a POSIX shell")
+	}
+
+	counter := filepath.Join(t.TempDir(), "invocations")
+	script := writeTestCredentialProcess(t, fmt.Sprintf(`
+n=$(($(cat '%[1]s' 2>/dev/null || echo 0) + 1))
+echo "$n" > '%[1]s'
+cat <<JSON
+%[2]s
+JSON
+`, counter, output))
+
+	invocations := func() int {
+		var n int
+		data, _ := os.ReadFile(counter)
+		_, _ = fmt.Sscan(string(data), &n)
+		return n
+	}
+	return &credentialProcess{command: script}, invocations
+}
+
+// writeTestCredentialProcess writes an executable shell script for the test
+// and returns its path.
+func writeTestCredentialProcess(t *testing.T, content string) string {
+	t.Helper()
+
+	script := filepath.Join(t.TempDir(), "credential-process")
+	if err := os.WriteFile(script, []byte("#!/bin/sh\n"+content), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	return script
+}
@@ -0,0 +1,230 @@
+package forwardnetworks
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// httpLogSubsystem is the tflog subsystem HTTP requests are logged under.
+// Its level is set with the TF_LOG_PROVIDER_FORWARDNETWORKS_HTTP environment
+// variable.
+const httpLogSubsystem = "http"
+
+// maxLoggedBodySize is the maximum number of bytes of a request or response
+// body that is logged.
+const maxLoggedBodySize = 64 * 1024
+
+// redacted replaces sensitive values in logs.
+const redacted = "REDACTED"
+
+// sensitiveHeaders lists the headers whose values are never logged.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Api-Key"}
+
+// sensitiveKeyParts lists the substrings of JSON keys and query parameters
+// whose values are never logged. Keys are compared in lower case.
+var sensitiveKeyParts = []string{"password", "passphrase", "secret", "token", "apikey", "api_key", "privatekey", "private_key", "community"}
+
+// debugTransport is an http.RoundTripper that logs every request and
+// response, redacting credentials, tokens and device secrets.
+//
+// The client does not pass a context to its requests, so the transport logs
+// with the context the provider was configured with.
+type debugTransport struct {
+	ctx  context.Context
+	next http.RoundTripper
+}
+
+// newDebugTransport returns a debugTransport logging to the HTTP subsystem of
+// the logger in ctx.
+func newDebugTransport(ctx context.Context, next http.RoundTripper) *debugTransport {
+	ctx = tflog.NewSubsystem(ctx, httpLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER_FORWARDNETWORKS", httpLogSubsystem))
+
+	return &debugTransport{ctx: ctx, next: next}
+}
+
+// RoundTrip logs the request, forwards it to the wrapped transport and logs
+// the response.
+func (t *debugTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fields := map[string]any{
+		"http_method":          req.Method,
+		"http_url":             redactURL(req.URL),
+		"http_request_headers": redactHeaders(req.Header),
+	}
+	if body, ok := requestBody(req); ok {
+		fields["http_request_body"] = body
+	}
+	tflog.SubsystemDebug(t.ctx, httpLogSubsystem, "Sending HTTP request", fields)
+
+	start := time.Now()
+	resp, err := roundTripper(t.next).RoundTrip(req)
+	latency := time.Since(start)
+	if err != nil {
+		tflog.SubsystemDebug(t.ctx, httpLogSubsystem, "HTTP request failed", map[string]any{
+			"http_method":     req.Method,
+			"http_url":        redactURL(req.URL),
+			"http_latency_ms": latency.Milliseconds(),
+			"error":           err.Error(),
+		})
+		return nil, err
+	}
+
+	fields = map[string]any{
+		"http_method":           req.Method,
+		"http_url":              redactURL(req.URL),
+		"http_status":           resp.StatusCode,
+		"http_latency_ms":       latency.Milliseconds(),
+		"http_response_headers": redactHeaders(resp.Header),
+	}
+	if body, ok := responseBody(resp); ok {
+		fields["http_response_body"] = body
+	}
+	tflog.SubsystemDebug(t.ctx, httpLogSubsystem, "Received HTTP response", fields)
+
+	return resp, nil
+}
+
+// requestBody returns the redacted body of a JSON request, read from a copy
+// of the body so that the request is left untouched.
+func requestBody(req *http.Request) (string, bool) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody == nil || !isJSON(req.Header) {
+		return "", false
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return "", false
+	}
+	defer body.Close()
+
+	data, err := io.ReadAll(io.LimitReader(body, maxLoggedBodySize+1))
+	if err != nil {
+		return "", false
+	}
+	return redactBody(data), true
+}
+
+// responseBody returns the redacted body of a JSON response. The part of the
+// body that is read is put back in front of the rest of it.
+func responseBody(resp *http.Response) (string, bool) {
+	if resp.Body == nil || resp.Body == http.NoBody || !isJSON(resp.Header) {
+		return "", false
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBodySize+1))
+	resp.Body = &replayBody{Reader: io.MultiReader(bytes.NewReader(data), resp.Body), Closer: resp.Body}
+	if err != nil {
+		return "", false
+	}
+	return redactBody(data), true
+}
+
+// replayBody is a response body whose beginning has already been read.
+type replayBody struct {
+	io.Reader
+	io.Closer
+}
+
+// isJSON reports whether the headers declare a JSON body.
+func isJSON(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get("Content-Type"))
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// redactBody returns a JSON body with the values of sensitive keys redacted.
+// Bodies that are too large or not valid JSON are omitted.
+func redactBody(data []byte) string {
+	if len(data) > maxLoggedBodySize {
+		return fmt.Sprintf("(body larger than %d bytes omitted)", maxLoggedBodySize)
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return ""
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Sprintf("(invalid JSON body of %d bytes omitted)", len(data))
+	}
+	encoded, err := json.Marshal(redactValue(value))
+	if err != nil {
+		return fmt.Sprintf("(body of %d bytes omitted)", len(data))
+	}
+	return string(encoded)
+}
+
+// redactValue redacts the values of sensitive keys in a decoded JSON value.
+func redactValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if isSensitiveKey(key) && item != nil && item != "" {
+				v[key] = redacted
+			} else {
+				v[key] = redactValue(item)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactValue(item)
+		}
+	}
+	return value
+}
+
+// redactHeaders returns the headers with the values of sensitive headers
+// redacted.
+func redactHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		headers[name] = strings.Join(values, ", ")
+	}
+	for _, name := range sensitiveHeaders {
+		if _, ok := headers[name]; ok {
+			headers[name] = redacted
+		}
+	}
+	return headers
+}
+
+// redactURL returns the URL with user credentials and the values of
+// sensitive query parameters redacted.
+func redactURL(u *url.URL) string {
+	redactedURL := *u
+	if u.User != nil {
+		redactedURL.User = url.User(redacted)
+	}
+
+	query := u.Query()
+	changed := false
+	for key := range query {
+		if isSensitiveKey(key) {
+			query.Set(key, redacted)
+			changed = true
+		}
+	}
+	if changed {
+		redactedURL.RawQuery = query.Encode()
+	}
+	return redactedURL.String()
+}
+
+// isSensitiveKey reports whether the value of a JSON key or query parameter
+// must not be logged.
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,396 @@
+package forwardnetworks
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deviceCredentialType lists the attributes a type of device credential
+// requires and accepts besides its name.
+type deviceCredentialType struct {
+	name     string
+	required []string
+	optional []string
+}
+
+// deviceCredentialTypes lists the device credential types accepted by the
+// Forward Networks API.
+var deviceCredentialTypes = []deviceCredentialType{
+	{name: "CLI", required: []string{"username", "password"}},
+	{name: "SSH_KEY", required: []string{"username", "private_key"}, optional: []string{"passphrase"}},
+	{name: "SNMP", required: []string{"community"}},
+	{name: "API_TOKEN", required: []string{"token"}, optional: []string{"username"}},
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &deviceCredentialResource{}
+	_ resource.ResourceWithConfigure      = &deviceCredentialResource{}
+	_ resource.ResourceWithModifyPlan     = &deviceCredentialResource{}
+	_ resource.ResourceWithImportState    = &deviceCredentialResource{}
+	_ resource.ResourceWithValidateConfig = &deviceCredentialResource{}
+)
+
+// NewDeviceCredentialResource is a helper function to simplify the provider implementation.
+func NewDeviceCredentialResource() resource.Resource {
+	return &deviceCredentialResource{}
+}
+
+// deviceCredentialResource is the resource implementation.
+type deviceCredentialResource struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// deviceCredentialResourceModel maps the resource schema data.
+type deviceCredentialResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	NetworkID     types.String `tfsdk:"network_id"`
+	Type          types.String `tfsdk:"type"`
+	Name          types.String `tfsdk:"name"`
+	Username      types.String `tfsdk:"username"`
+	Password      types.String `tfsdk:"password"`
+	PrivateKey    types.String `tfsdk:"private_key"`
+	Passphrase    types.String `tfsdk:"passphrase"`
+	Community     types.String `tfsdk:"community"`
+	Token         types.String `tfsdk:"token"`
+	SecretVersion types.Int64  `tfsdk:"secret_version"`
+}
+
+// Metadata returns the resource type name.
+func (r *deviceCredentialResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_credential"
+}
+
+// Schema defines the schema for the resource.
+func (r *deviceCredentialResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Secrets are write-only, so they are never stored in the state, and the
+	// API never returns them, so changes are only sent when secret_version
+	// changes.
+	secret := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Description: description + " Write-only and never returned by Forward Networks, " +
+				"so it is only sent when the credential is created or updated, such as when secret_version changes.",
+			Optional:  true,
+			Sensitive: true,
+			WriteOnly: true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a credential Forward Networks uses to collect devices of a network. " +
+			"The attributes that must be set depend on the type of the credential. " +
+			"Secrets are write-only, which requires Terraform 1.11 or later.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the credential.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The network ID the credential belongs to. Defaults to the default_network_id of the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the credential: CLI for a username and password, SSH_KEY for a username and SSH private key, " +
+					"SNMP for an SNMP community, or API_TOKEN for a token of a cloud account or controller. Changing it replaces the credential.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the credential.",
+				Required:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username to log in with. Required for CLI and SSH_KEY credentials, and optional for API_TOKEN credentials.",
+				Optional:    true,
+			},
+			"password":    secret("Password of CLI credentials."),
+			"private_key": secret("PEM-encoded SSH private key of SSH_KEY credentials."),
+			"passphrase":  secret("Passphrase of the private key of SSH_KEY credentials, if it is encrypted."),
+			"community":   secret("SNMP community of SNMP credentials."),
+			"token":       secret("API token of API_TOKEN credentials."),
+			"secret_version": schema.Int64Attribute{
+				Description: "Version of the secrets. Change it to send the configured secrets to Forward Networks, such as when rotating them.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the type is valid and that exactly the attributes
+// of that type are set.
+func (r *deviceCredentialResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config deviceCredentialResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Type.IsUnknown() {
+		return
+	}
+
+	var credentialType *deviceCredentialType
+	var names []string
+	for i := range deviceCredentialTypes {
+		names = append(names, deviceCredentialTypes[i].name)
+		if deviceCredentialTypes[i].name == config.Type.ValueString() {
+			credentialType = &deviceCredentialTypes[i]
+		}
+	}
+	if credentialType == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Device Credential Type",
+			"The type must be one of "+strings.Join(names, ", ")+".",
+		)
+		return
+	}
+
+	attributes := config.attributes()
+	accepted := map[string]bool{}
+	for _, name := range credentialType.required {
+		accepted[name] = true
+		if attributes[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Device Credential Attribute",
+				"The "+name+" attribute is required for "+credentialType.name+" credentials.",
+			)
+		}
+	}
+	for _, name := range credentialType.optional {
+		accepted[name] = true
+	}
+
+	unaccepted := []string{}
+	for name, value := range attributes {
+		if !accepted[name] && !value.IsNull() {
+			unaccepted = append(unaccepted, name)
+		}
+	}
+	sort.Strings(unaccepted)
+	for _, name := range unaccepted {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(name),
+			"Invalid Device Credential Attribute",
+			"The "+name+" attribute cannot be set for "+credentialType.name+" credentials.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *deviceCredentialResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.defaults = data.defaults
+}
+
+// ModifyPlan fills in the network ID from the default_network_id of the
+// provider when it is omitted, and replaces the credential when its network
+// changes.
+func (r *deviceCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the credential is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.defaults.planNetworkID(ctx, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *deviceCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan, and the write-only secrets from config
+	var plan, config deviceCredentialResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new credential
+	created, err := r.client.CreateDeviceCredential(plan.NetworkID.ValueString(), plan.credential(config))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Device Credential",
+			"Could not create device credential on network ID "+plan.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.refresh(created)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data. Secrets are
+// write-only, so they are never part of the state.
+func (r *deviceCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state deviceCredentialResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed credential value from Forward Networks
+	credential, err := r.client.GetDeviceCredential(state.NetworkID.ValueString(), state.ID.ValueString())
+	if errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Forward Networks Device Credential",
+			"Could not read device credential ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite attributes with refreshed state
+	state.refresh(credential)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *deviceCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan, and the write-only secrets from config
+	var plan, config deviceCredentialResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Replace the existing credential, including its secrets
+	updated, err := r.client.UpdateDeviceCredential(plan.NetworkID.ValueString(), plan.ID.ValueString(), plan.credential(config))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Forward Networks Device Credential",
+			"Could not update device credential ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with the refreshed credential
+	plan.refresh(updated)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *deviceCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state deviceCredentialResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing credential
+	err := r.client.DeleteDeviceCredential(state.NetworkID.ValueString(), state.ID.ValueString())
+	if err != nil && !errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Forward Networks Device Credential",
+			"Could not delete device credential ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing credential using an import ID of the form
+// network_id/credential_id. Secrets are not imported, so secret_version must
+// be set for the next apply to send the configured secrets.
+func (r *deviceCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	networkID, credentialID, ok := strings.Cut(req.ID, "/")
+	if !ok || networkID == "" || credentialID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format network_id/credential_id, got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), networkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), credentialID)...)
+}
+
+// attributes returns the type-specific attributes of the model by name.
+func (m *deviceCredentialResourceModel) attributes() map[string]types.String {
+	return map[string]types.String{
+		"username":    m.Username,
+		"password":    m.Password,
+		"private_key": m.PrivateKey,
+		"passphrase":  m.Passphrase,
+		"community":   m.Community,
+		"token":       m.Token,
+	}
+}
+
+// credential builds the API representation of the credential from the model
+// and the write-only secrets of config.
+func (m *deviceCredentialResourceModel) credential(config deviceCredentialResourceModel) forwardnetworks.DeviceCredential {
+	return forwardnetworks.DeviceCredential{
+		Type:       m.Type.ValueString(),
+		Name:       m.Name.ValueString(),
+		Username:   m.Username.ValueString(),
+		Password:   config.Password.ValueString(),
+		PrivateKey: config.PrivateKey.ValueString(),
+		Passphrase: config.Passphrase.ValueString(),
+		Community:  config.Community.ValueString(),
+		Token:      config.Token.ValueString(),
+	}
+}
+
+// refresh updates the model from the API representation of the credential.
+// The API never returns the secrets.
+func (m *deviceCredentialResourceModel) refresh(credential *forwardnetworks.DeviceCredential) {
+	m.ID = types.StringValue(credential.ID)
+	m.Type = types.StringValue(credential.Type)
+	m.Name = types.StringValue(credential.Name)
+	m.Username = stringValueOrNull(credential.Username)
+}
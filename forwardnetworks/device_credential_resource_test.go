@@ -0,0 +1,171 @@
+package forwardnetworks
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccDeviceCredentialResource(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("credentials")
+
+	config := func(password string, secretVersion int) string {
+		return srv.providerConfig() + fmt.Sprintf(`
+resource "forwardnetworks_device_credential" "test" {
+  network_id     = %q
+  type           = "CLI"
+  name           = "core routers"
+  username       = "collector"
+  password       = %q
+  secret_version = %d
+}
+`, networkID, password, secretVersion)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// Write-only attributes require Terraform 1.11.
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config("first-secret", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_device_credential.test", "type", "CLI"),
+					resource.TestCheckResourceAttr("forwardnetworks_device_credential.test", "name", "core routers"),
+					resource.TestCheckResourceAttr("forwardnetworks_device_credential.test", "username", "collector"),
+					resource.TestCheckNoResourceAttr("forwardnetworks_device_credential.test", "password"),
+					resource.TestCheckResourceAttrSet("forwardnetworks_device_credential.test", "id"),
+					testAccCheckDeviceCredentialPassword(srv, networkID, "forwardnetworks_device_credential.test", "first-secret"),
+				),
+			},
+			// Changing a secret without secret_version does not cause a diff
+			{
+				Config: config("ignored-secret", 1),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: testAccCheckDeviceCredentialPassword(srv, networkID, "forwardnetworks_device_credential.test", "first-secret"),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "forwardnetworks_device_credential.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccIntentCheckImportID("forwardnetworks_device_credential.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_version"},
+			},
+			// Changing secret_version sends the secrets
+			{
+				Config: config("second-secret", 2),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forwardnetworks_device_credential.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("forwardnetworks_device_credential.test", "password"),
+					resource.TestCheckResourceAttr("forwardnetworks_device_credential.test", "secret_version", "2"),
+					testAccCheckDeviceCredentialPassword(srv, networkID, "forwardnetworks_device_credential.test", "second-secret"),
+				),
+			},
+			// Changing the type replaces the credential
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_device_credential" "test" {
+  network_id = "` + networkID + `"
+  type       = "SNMP"
+  name       = "core routers"
+  community  = "monitoring"
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forwardnetworks_device_credential.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_device_credential.test", "type", "SNMP"),
+					resource.TestCheckNoResourceAttr("forwardnetworks_device_credential.test", "username"),
+					resource.TestCheckNoResourceAttr("forwardnetworks_device_credential.test", "community"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDeviceCredentialResource_invalidAttributes(t *testing.T) {
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_device_credential" "test" {
+  network_id = "1"
+  type       = "TELNET"
+  name       = "legacy"
+}
+`,
+				ExpectError: regexp.MustCompile("The type must be one of CLI, SSH_KEY, SNMP, API_TOKEN"),
+			},
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_device_credential" "test" {
+  network_id = "1"
+  type       = "SSH_KEY"
+  name       = "core routers"
+  username   = "collector"
+}
+`,
+				ExpectError: regexp.MustCompile("The private_key attribute is required for SSH_KEY credentials"),
+			},
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_device_credential" "test" {
+  network_id = "1"
+  type       = "SNMP"
+  name       = "core routers"
+  community  = "monitoring"
+  password   = "secret"
+}
+`,
+				ExpectError: regexp.MustCompile("The password attribute cannot be set for SNMP credentials"),
+			},
+		},
+	})
+}
+
+// testAccCheckDeviceCredentialPassword verifies the credential stored by the
+// fake server has the expected password.
+func testAccCheckDeviceCredentialPassword(srv *fakeServer, networkID, resourceName, password string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		credential := srv.deviceCredential(networkID, rs.Primary.ID)
+		if credential == nil {
+			return fmt.Errorf("device credential %s does not exist", rs.Primary.ID)
+		}
+		if credential.Password != password {
+			return fmt.Errorf("unexpected password of device credential %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
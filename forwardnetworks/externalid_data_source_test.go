@@ -0,0 +1,31 @@
+package forwardnetworks
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccExternalIdDataSource(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("external-id")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_external_id" "test" {
+  network_id = "` + networkID + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_external_id.test", "network_id", networkID),
+					resource.TestCheckResourceAttr("data.forwardnetworks_external_id.test", "id", networkID),
+					resource.TestCheckResourceAttr("data.forwardnetworks_external_id.test", "external_id", "fwd-"+networkID+"-external"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,1204 @@
+package forwardnetworks
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+)
+
+const (
+	testUsername = "test-user"
+	testPassword = "test-password"
+	testAPIToken = "test-api-token"
+)
+
+// fakeServer is an in-memory stand-in for the Forward Networks REST API used
+// by the acceptance tests. It implements just enough of the API for the
+// resources and data sources in this provider, so the tests can run without
+// network access.
+type fakeServer struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	nextID      int
+	version     string
+	networks    map[string]*forwardnetworks.Network
+	externalIDs map[string]string
+	workspaces  map[string]forwardnetworks.WorkspaceNetwork
+	snapshots   map[string][]*forwardnetworks.Snapshot
+	collections map[string]int
+	collection  fakeCollection
+	uploads     map[string]fakeUpload
+	archives    map[string][]byte
+	nqeResults  map[string][]map[string]any
+	lastNqe     forwardnetworks.NqeQuery
+	nqeLibrary  map[string]*forwardnetworks.NqeLibraryQuery
+	checks      map[string]map[string]*forwardnetworks.Check
+	credentials map[string]map[string]*forwardnetworks.DeviceCredential
+	devices     map[string]map[string]*forwardnetworks.ClassicDevice
+	batches     int
+	violations  map[string][]string
+	paths       map[string][]forwardnetworks.Path
+	lastPaths   forwardnetworks.PathSearchQuery
+	failures    map[string]bool
+}
+
+// newFakeServer starts a fakeServer that is shut down when the test ends.
+func newFakeServer(t *testing.T) *fakeServer {
+	t.Helper()
+
+	s := &fakeServer{
+		nextID:      100000,
+		version:     "23.4.1-01",
+		networks:    map[string]*forwardnetworks.Network{},
+		externalIDs: map[string]string{},
+		workspaces:  map[string]forwardnetworks.WorkspaceNetwork{},
+		snapshots:   map[string][]*forwardnetworks.Snapshot{},
+		collections: map[string]int{},
+		collection:  fakeCollection{polls: 1, state: snapshotStateProcessed},
+		uploads:     map[string]fakeUpload{},
+		archives:    map[string][]byte{},
+		nqeResults:  map[string][]map[string]any{},
+		nqeLibrary:  map[string]*forwardnetworks.NqeLibraryQuery{},
+		checks:      map[string]map[string]*forwardnetworks.Check{},
+		credentials: map[string]map[string]*forwardnetworks.DeviceCredential{},
+		devices:     map[string]map[string]*forwardnetworks.ClassicDevice{},
+		violations:  map[string][]string{},
+		paths:       map[string][]forwardnetworks.Path{},
+		failures:    map[string]bool{},
+	}
+	s.Server = httptest.NewServer(s)
+	t.Cleanup(s.Close)
+
+	return s
+}
+
+// providerConfig returns a provider block pointing at the fake server.
+func (s *fakeServer) providerConfig() string {
+	return fmt.Sprintf(`
+provider "forwardnetworks" {
+  username = %q
+  password = %q
+  host     = %q
+}
+`, testUsername, testPassword, s.URL)
+}
+
+// addNetwork seeds a network and returns its identifier.
+func (s *fakeServer) addNetwork(name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	network := s.newNetwork(name)
+	return network.ID
+}
+
+// networkCount returns the number of networks stored by the fake server.
+func (s *fakeServer) networkCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.networks)
+}
+
+// failRoute makes the route with the given method and pattern respond with an
+// internal server error.
+func (s *fakeServer) failRoute(method, pattern string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures[method+" "+pattern] = true
+}
+
+// restoreRoute undoes failRoute for the route with the given method and
+// pattern.
+func (s *fakeServer) restoreRoute(method, pattern string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.failures, method+" "+pattern)
+}
+
+// addWorkspaceNetwork seeds a workspace network of parentID and returns its
+// identifier.
+func (s *fakeServer) addWorkspaceNetwork(parentID, name string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	network := s.newNetwork(name)
+	network.ParentID = parentID
+	return network.ID
+}
+
+// externalID returns the external ID currently stored for a network.
+func (s *fakeServer) externalID(networkID string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.externalIDs[networkID]
+}
+
+// workspace returns the request that created the workspace network id.
+func (s *fakeServer) workspace(id string) forwardnetworks.WorkspaceNetwork {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.workspaces[id]
+}
+
+// network returns a copy of the stored network, or nil if it does not exist.
+func (s *fakeServer) network(id string) *forwardnetworks.Network {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	network, ok := s.networks[id]
+	if !ok {
+		return nil
+	}
+	copied := *network
+	return &copied
+}
+
+// addSnapshot seeds a snapshot of a network created at the given time and
+// returns its identifier.
+func (s *fakeServer) addSnapshot(networkID, state string, createdAt time.Time) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.newSnapshot(networkID, state, createdAt).ID
+}
+
+func (s *fakeServer) newSnapshot(networkID, state string, createdAt time.Time) *forwardnetworks.Snapshot {
+	s.nextID++
+	snapshot := &forwardnetworks.Snapshot{
+		ID:        strconv.Itoa(s.nextID),
+		State:     state,
+		CreatedAt: createdAt.UnixMilli(),
+	}
+	if state == snapshotStateProcessed {
+		snapshot.ProcessedAt = createdAt.Add(5 * time.Minute).UnixMilli()
+	}
+	s.snapshots[networkID] = append(s.snapshots[networkID], snapshot)
+	return snapshot
+}
+
+// fakeCollection controls how collections started on the fake server
+// progress.
+type fakeCollection struct {
+	// polls is the number of times a collected snapshot is read while
+	// processing before it reaches its final state.
+	polls int
+	// state is the final state of collected snapshots.
+	state string
+	// last is the most recent collection request.
+	last forwardnetworks.CollectionRequest
+}
+
+// setCollectionResult sets the number of polls after which collected
+// snapshots reach the final state.
+func (s *fakeServer) setCollectionResult(polls int, state string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.collection.polls = polls
+	s.collection.state = state
+}
+
+// lastCollection returns the most recent collection request.
+func (s *fakeServer) lastCollection() forwardnetworks.CollectionRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.collection.last
+}
+
+// fakeUpload records a snapshot archive uploaded to the fake server.
+type fakeUpload struct {
+	filename string
+	sha256   string
+	// streamed is whether the archive was sent without a Content-Length,
+	// as it is when the client streams it.
+	streamed bool
+}
+
+// upload returns the upload that created a snapshot.
+func (s *fakeServer) upload(snapshotID string) fakeUpload {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.uploads[snapshotID]
+}
+
+// setSnapshotArchive sets the archive a snapshot is exported as.
+func (s *fakeServer) setSnapshotArchive(snapshotID string, archive []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.archives[snapshotID] = archive
+}
+
+// snapshot returns the stored snapshot with the given ID, or nil if it does
+// not exist. The caller must hold s.mu.
+func (s *fakeServer) snapshot(id string) *forwardnetworks.Snapshot {
+	for _, snapshots := range s.snapshots {
+		for _, snapshot := range snapshots {
+			if snapshot.ID == id {
+				return snapshot
+			}
+		}
+	}
+	return nil
+}
+
+// setNqeResult sets the rows returned for an NQE query, identified either by
+// its source text or by its library query ID.
+func (s *fakeServer) setNqeResult(query string, rows []map[string]any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nqeResults[query] = rows
+}
+
+// lastNqeQuery returns the most recent NQE query run against the server.
+func (s *fakeServer) lastNqeQuery() forwardnetworks.NqeQuery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lastNqe
+}
+
+// nqeLibraryQuery returns a copy of the stored NQE library query, or nil if
+// it does not exist.
+func (s *fakeServer) nqeLibraryQuery(id string) *forwardnetworks.NqeLibraryQuery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	query, ok := s.nqeLibrary[id]
+	if !ok {
+		return nil
+	}
+	copied := *query
+	return &copied
+}
+
+// nqeLibraryQueryCount returns the number of stored NQE library queries.
+func (s *fakeServer) nqeLibraryQueryCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.nqeLibrary)
+}
+
+// editNqeLibraryQuery changes the source of the NQE library query at the
+// given path, as if it had been edited in the Forward Networks UI.
+func (s *fakeServer) editNqeLibraryQuery(path, source string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, query := range s.nqeLibrary {
+		if query.Path == path {
+			query.SourceCode = source
+			query.Committed = false
+		}
+	}
+}
+
+// check returns a copy of the stored check, or nil if it does not exist.
+func (s *fakeServer) check(networkID, checkID string) *forwardnetworks.Check {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	check, ok := s.checks[networkID][checkID]
+	if !ok {
+		return nil
+	}
+	copied := *check
+	return &copied
+}
+
+// setCheckStatus sets the status of every check with the given name on a
+// network.
+func (s *fakeServer) setCheckStatus(networkID, name, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, check := range s.checks[networkID] {
+		if check.Name == name {
+			check.Status = status
+		}
+	}
+}
+
+// setCheckViolations marks every check with the given name on a network as
+// failing with the given violations.
+func (s *fakeServer) setCheckViolations(networkID, name string, violations []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, check := range s.checks[networkID] {
+		if check.Name == name {
+			check.Status = "FAIL"
+			s.violations[check.ID] = violations
+		}
+	}
+}
+
+// addCheck seeds a check on a network and returns its identifier.
+func (s *fakeServer) addCheck(networkID string, check forwardnetworks.Check) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nextID++
+	check.ID = "C-" + strconv.Itoa(s.nextID)
+	if check.Status == "" {
+		check.Status = "PASS"
+	}
+	s.checks[networkID][check.ID] = &check
+	return check.ID
+}
+
+// deviceCredential returns a copy of the stored device credential, including
+// the secrets the API never returns, or nil if it does not exist.
+func (s *fakeServer) deviceCredential(networkID, credentialID string) *forwardnetworks.DeviceCredential {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	credential, ok := s.credentials[networkID][credentialID]
+	if !ok {
+		return nil
+	}
+	copied := *credential
+	return &copied
+}
+
+// addClassicDevice seeds a classic device on a network.
+func (s *fakeServer) addClassicDevice(networkID string, device forwardnetworks.ClassicDevice) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.devices[networkID][device.Name] = &device
+}
+
+// classicDevice returns a copy of the stored classic device, or nil if it
+// does not exist.
+func (s *fakeServer) classicDevice(networkID, name string) *forwardnetworks.ClassicDevice {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	device, ok := s.devices[networkID][name]
+	if !ok {
+		return nil
+	}
+	copied := *device
+	return &copied
+}
+
+// classicDeviceCount returns the number of classic devices of a network.
+func (s *fakeServer) classicDeviceCount(networkID string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.devices[networkID])
+}
+
+// classicDeviceBatches returns the number of batches of classic devices that
+// were added or deleted.
+func (s *fakeServer) classicDeviceBatches() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.batches
+}
+
+// setPaths sets the paths returned by path searches for traffic sent to the
+// given destination.
+func (s *fakeServer) setPaths(destination string, paths []forwardnetworks.Path) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.paths[destination] = paths
+}
+
+// lastPathSearch returns the most recent path search run against the server.
+func (s *fakeServer) lastPathSearch() forwardnetworks.PathSearchQuery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.lastPaths
+}
+
+// latestProcessedSnapshot returns the most recently created processed
+// snapshot of a network, or nil if there is none.
+func (s *fakeServer) latestProcessedSnapshot(networkID string) *forwardnetworks.Snapshot {
+	var latest *forwardnetworks.Snapshot
+	for _, snapshot := range s.snapshots[networkID] {
+		if snapshot.State != snapshotStateProcessed {
+			continue
+		}
+		if latest == nil || snapshot.CreatedAt > latest.CreatedAt {
+			latest = snapshot
+		}
+	}
+	return latest
+}
+
+func (s *fakeServer) newNetwork(name string) *forwardnetworks.Network {
+	s.nextID++
+	id := strconv.Itoa(s.nextID)
+	network := &forwardnetworks.Network{
+		ID:        id,
+		Name:      name,
+		OrgID:     "101",
+		Creator:   testUsername,
+		CreatorID: "201",
+		CreatedAt: 1682000000000 + int64(s.nextID),
+	}
+	s.networks[id] = network
+	s.externalIDs[id] = "fwd-" + id + "-external"
+	s.checks[id] = map[string]*forwardnetworks.Check{}
+	s.credentials[id] = map[string]*forwardnetworks.DeviceCredential{}
+	s.devices[id] = map[string]*forwardnetworks.ClassicDevice{}
+	return network
+}
+
+// ServeHTTP dispatches requests to the handler matching their method and path.
+func (s *fakeServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !authorized(r) {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, route := range s.routes() {
+		if params, ok := match(r, route.method, route.pattern); ok {
+			if s.failures[route.method+" "+route.pattern] {
+				http.Error(w, "injected failure", http.StatusInternalServerError)
+				return
+			}
+			route.handler(w, r, params)
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+// authorized reports whether the request carries either the test username and
+// password or the test API token.
+func authorized(r *http.Request) bool {
+	if user, pass, ok := r.BasicAuth(); ok {
+		return user == testUsername && pass == testPassword
+	}
+	return r.Header.Get("Authorization") == "Bearer "+testAPIToken
+}
+
+type fakeRoute struct {
+	method  string
+	pattern string
+	handler func(w http.ResponseWriter, r *http.Request, params []string)
+}
+
+func (s *fakeServer) routes() []fakeRoute {
+	return []fakeRoute{
+		{http.MethodGet, "/api/version", s.getVersion},
+		{http.MethodGet, "/api/networks", s.getNetworks},
+		{http.MethodPost, "/api/networks", s.createNetwork},
+		{http.MethodGet, "/api/networks/*", s.getNetwork},
+		{http.MethodPatch, "/api/networks/*", s.updateNetwork},
+		{http.MethodDelete, "/api/networks/*", s.deleteNetwork},
+		{http.MethodPost, "/api/networks/*/workspaces", s.createWorkspaceNetwork},
+		{http.MethodGet, "/api/networks/*/externalId", s.getExternalID},
+		{http.MethodPut, "/api/networks/*/externalId", s.setExternalID},
+		{http.MethodPost, "/api/networks/*/externalId", s.rotateExternalID},
+		{http.MethodGet, "/api/networks/*/snapshots", s.getSnapshots},
+		{http.MethodPost, "/api/networks/*/snapshots", s.importSnapshot},
+		{http.MethodPost, "/api/networks/*/startcollection", s.startCollection},
+		{http.MethodGet, "/api/snapshots/*", s.getSnapshot},
+		{http.MethodPost, "/api/nqe", s.runNqeQuery},
+		{http.MethodGet, "/api/nqe/library/queries", s.getNqeLibraryQueries},
+		{http.MethodPost, "/api/nqe/library/queries", s.createNqeLibraryQuery},
+		{http.MethodGet, "/api/nqe/library/queries/*", s.getNqeLibraryQuery},
+		{http.MethodPatch, "/api/nqe/library/queries/*", s.updateNqeLibraryQuery},
+		{http.MethodDelete, "/api/nqe/library/queries/*", s.deleteNqeLibraryQuery},
+		{http.MethodPost, "/api/nqe/library/queries/*/commit", s.commitNqeLibraryQuery},
+		{http.MethodPost, "/api/networks/*/paths", s.searchPaths},
+		{http.MethodGet, "/api/networks/*/checks", s.getCheckResults},
+		{http.MethodPost, "/api/networks/*/checks", s.createCheck},
+		{http.MethodGet, "/api/networks/*/checks/*", s.getCheck},
+		{http.MethodPatch, "/api/networks/*/checks/*", s.updateCheck},
+		{http.MethodDelete, "/api/networks/*/checks/*", s.deleteCheck},
+		{http.MethodPost, "/api/networks/*/deviceCredentials", s.createDeviceCredential},
+		{http.MethodGet, "/api/networks/*/deviceCredentials/*", s.getDeviceCredential},
+		{http.MethodPatch, "/api/networks/*/deviceCredentials/*", s.updateDeviceCredential},
+		{http.MethodDelete, "/api/networks/*/deviceCredentials/*", s.deleteDeviceCredential},
+		{http.MethodGet, "/api/networks/*/classic-devices", s.getClassicDevices},
+		{http.MethodPost, "/api/networks/*/classic-devices", s.addClassicDevices},
+		{http.MethodPost, "/api/networks/*/classic-devices/batch-delete", s.deleteClassicDevices},
+		{http.MethodGet, "/api/networks/*/classic-devices/*", s.getClassicDevice},
+		{http.MethodDelete, "/api/networks/*/classic-devices/*", s.deleteClassicDevice},
+	}
+}
+
+func (s *fakeServer) getVersion(w http.ResponseWriter, _ *http.Request, _ []string) {
+	writeJSON(w, forwardnetworks.Version{Version: s.version})
+}
+
+func (s *fakeServer) getNetworks(w http.ResponseWriter, _ *http.Request, _ []string) {
+	networks := []forwardnetworks.Network{}
+	for _, network := range s.networks {
+		networks = append(networks, *network)
+	}
+	writeJSON(w, networks)
+}
+
+func (s *fakeServer) createNetwork(w http.ResponseWriter, r *http.Request, _ []string) {
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		http.Error(w, "name is required", http.StatusBadRequest)
+		return
+	}
+	writeJSON(w, s.newNetwork(name))
+}
+
+func (s *fakeServer) createWorkspaceNetwork(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.networks[params[0]]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var workspace forwardnetworks.WorkspaceNetwork
+	if err := json.NewDecoder(r.Body).Decode(&workspace); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if workspace.Name == "" {
+		http.Error(w, "name is required", http.StatusBadRequest)
+		return
+	}
+	network := s.newNetwork(workspace.Name)
+	network.ParentID = params[0]
+	network.Note = workspace.Note
+	s.workspaces[network.ID] = workspace
+	writeJSON(w, network)
+}
+
+func (s *fakeServer) getNetwork(w http.ResponseWriter, r *http.Request, params []string) {
+	network, ok := s.networks[params[0]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, network)
+}
+
+func (s *fakeServer) updateNetwork(w http.ResponseWriter, r *http.Request, params []string) {
+	network, ok := s.networks[params[0]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var update forwardnetworks.Network
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if update.Name != "" {
+		network.Name = update.Name
+	}
+	network.Note = update.Note
+	writeJSON(w, network)
+}
+
+func (s *fakeServer) deleteNetwork(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.networks[params[0]]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	delete(s.networks, params[0])
+	delete(s.externalIDs, params[0])
+	delete(s.workspaces, params[0])
+	delete(s.snapshots, params[0])
+	delete(s.checks, params[0])
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *fakeServer) getExternalID(w http.ResponseWriter, r *http.Request, params []string) {
+	externalID, ok := s.externalIDs[params[0]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, forwardnetworks.ExternalId{ExternalId: externalID})
+}
+
+func (s *fakeServer) setExternalID(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.networks[params[0]]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var externalID forwardnetworks.ExternalId
+	if err := json.NewDecoder(r.Body).Decode(&externalID); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if externalID.ExternalId == "" {
+		http.Error(w, "externalId is required", http.StatusBadRequest)
+		return
+	}
+	s.externalIDs[params[0]] = externalID.ExternalId
+	writeJSON(w, externalID)
+}
+
+func (s *fakeServer) rotateExternalID(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.networks[params[0]]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	s.nextID++
+	s.externalIDs[params[0]] = "fwd-" + params[0] + "-external-" + strconv.Itoa(s.nextID)
+	writeJSON(w, forwardnetworks.ExternalId{ExternalId: s.externalIDs[params[0]]})
+}
+
+func (s *fakeServer) getSnapshots(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.networks[params[0]]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	snapshots := []forwardnetworks.Snapshot{}
+	for _, snapshot := range s.snapshots[params[0]] {
+		snapshots = append(snapshots, *snapshot)
+	}
+	writeJSON(w, forwardnetworks.NetworkSnapshots{Snapshots: snapshots})
+}
+
+// importSnapshot stores an uploaded snapshot archive, which is processed like
+// a started collection.
+func (s *fakeServer) importSnapshot(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.networks[params[0]]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	reader, err := r.MultipartReader()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	part, err := reader.NextPart()
+	if err != nil || part.FormName() != "file" {
+		http.Error(w, "file is required", http.StatusBadRequest)
+		return
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, part); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	snapshot := s.newSnapshot(params[0], "UPLOADED", time.Now())
+	s.collections[snapshot.ID] = s.collection.polls
+	s.uploads[snapshot.ID] = fakeUpload{
+		filename: part.FileName(),
+		sha256:   hex.EncodeToString(hash.Sum(nil)),
+		streamed: r.ContentLength == -1,
+	}
+	writeJSON(w, snapshot)
+}
+
+func (s *fakeServer) startCollection(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.networks[params[0]]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var request forwardnetworks.CollectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.collection.last = request
+	snapshot := s.newSnapshot(params[0], "COLLECTING", time.Now())
+	s.collections[snapshot.ID] = s.collection.polls
+	writeJSON(w, snapshot)
+}
+
+// getSnapshot returns a snapshot, advancing snapshots of started collections
+// towards their final state each time they are read. Requests accepting a zip
+// archive export the snapshot instead.
+func (s *fakeServer) getSnapshot(w http.ResponseWriter, r *http.Request, params []string) {
+	snapshot := s.snapshot(params[0])
+	if snapshot == nil {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Header.Get("Accept") == "application/zip" {
+		s.exportSnapshot(w, snapshot)
+		return
+	}
+	if polls, ok := s.collections[snapshot.ID]; ok {
+		if polls > 0 {
+			snapshot.State = "PROCESSING"
+			s.collections[snapshot.ID] = polls - 1
+		} else {
+			snapshot.State = s.collection.state
+			if snapshot.State == snapshotStateProcessed {
+				snapshot.ProcessedAt = time.Now().UnixMilli()
+			}
+			delete(s.collections, snapshot.ID)
+		}
+	}
+	writeJSON(w, snapshot)
+}
+
+// exportSnapshot streams the archive of a snapshot in chunks, without a
+// Content-Length, as large archives are.
+func (s *fakeServer) exportSnapshot(w http.ResponseWriter, snapshot *forwardnetworks.Snapshot) {
+	archive, ok := s.archives[snapshot.ID]
+	if !ok || snapshot.State != snapshotStateProcessed {
+		http.Error(w, "snapshot cannot be exported", http.StatusConflict)
+		return
+	}
+	w.Header().Set("Content-Type", "application/zip")
+	for len(archive) > 0 {
+		chunk := archive
+		if len(chunk) > 1024 {
+			chunk = chunk[:1024]
+		}
+		archive = archive[len(chunk):]
+		if _, err := w.Write(chunk); err != nil {
+			return
+		}
+		w.(http.Flusher).Flush()
+	}
+}
+
+func (s *fakeServer) runNqeQuery(w http.ResponseWriter, r *http.Request, _ []string) {
+	networkID := r.URL.Query().Get("networkId")
+	if _, ok := s.networks[networkID]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	snapshotID := r.URL.Query().Get("snapshotId")
+	if snapshotID == "" {
+		latest := s.latestProcessedSnapshot(networkID)
+		if latest == nil {
+			http.Error(w, "network has no processed snapshot", http.StatusBadRequest)
+			return
+		}
+		snapshotID = latest.ID
+	}
+
+	var query forwardnetworks.NqeQuery
+	if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.lastNqe = query
+
+	key := query.Query
+	if query.QueryID != "" {
+		key = query.QueryID
+	}
+	rows, ok := s.nqeResults[key]
+	if !ok {
+		http.Error(w, "unknown query", http.StatusBadRequest)
+		return
+	}
+	if options := query.QueryOptions; options != nil {
+		if options.Offset < len(rows) {
+			rows = rows[options.Offset:]
+		} else {
+			rows = nil
+		}
+		if options.Limit > 0 && options.Limit < len(rows) {
+			rows = rows[:options.Limit]
+		}
+	}
+	writeJSON(w, forwardnetworks.NqeQueryResult{SnapshotID: snapshotID, Items: rows})
+}
+
+func (s *fakeServer) getNqeLibraryQueries(w http.ResponseWriter, _ *http.Request, _ []string) {
+	queries := []forwardnetworks.NqeLibraryQuery{}
+	for _, query := range s.nqeLibrary {
+		queries = append(queries, *query)
+	}
+	writeJSON(w, queries)
+}
+
+func (s *fakeServer) createNqeLibraryQuery(w http.ResponseWriter, r *http.Request, _ []string) {
+	var query forwardnetworks.NqeLibraryQuery
+	if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	for _, existing := range s.nqeLibrary {
+		if existing.Path == query.Path {
+			http.Error(w, "a query already exists at "+query.Path, http.StatusConflict)
+			return
+		}
+	}
+	s.nextID++
+	query.QueryID = "Q_" + strconv.Itoa(s.nextID)
+	query.Committed = false
+	query.CommitID = ""
+	s.nqeLibrary[query.QueryID] = &query
+	writeJSON(w, query)
+}
+
+func (s *fakeServer) getNqeLibraryQuery(w http.ResponseWriter, r *http.Request, params []string) {
+	query, ok := s.nqeLibrary[params[0]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, query)
+}
+
+func (s *fakeServer) updateNqeLibraryQuery(w http.ResponseWriter, r *http.Request, params []string) {
+	query, ok := s.nqeLibrary[params[0]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var update forwardnetworks.NqeLibraryQuery
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if update.SourceCode != query.SourceCode {
+		query.Committed = false
+	}
+	query.Path = update.Path
+	query.SourceCode = update.SourceCode
+	query.Description = update.Description
+	writeJSON(w, query)
+}
+
+func (s *fakeServer) deleteNqeLibraryQuery(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.nqeLibrary[params[0]]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	delete(s.nqeLibrary, params[0])
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *fakeServer) commitNqeLibraryQuery(w http.ResponseWriter, r *http.Request, params []string) {
+	query, ok := s.nqeLibrary[params[0]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	s.nextID++
+	query.Committed = true
+	query.CommitID = "C_" + strconv.Itoa(s.nextID)
+	writeJSON(w, query)
+}
+
+func (s *fakeServer) searchPaths(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.networks[params[0]]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	snapshotID := r.URL.Query().Get("snapshotId")
+	if snapshotID == "" {
+		latest := s.latestProcessedSnapshot(params[0])
+		if latest == nil {
+			http.Error(w, "network has no processed snapshot", http.StatusBadRequest)
+			return
+		}
+		snapshotID = latest.ID
+	}
+
+	var query forwardnetworks.PathSearchQuery
+	if err := json.NewDecoder(r.Body).Decode(&query); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.lastPaths = query
+
+	paths := s.paths[query.Destination]
+	if query.MaxResults > 0 && query.MaxResults < len(paths) {
+		paths = paths[:query.MaxResults]
+	}
+	writeJSON(w, forwardnetworks.PathSearchResult{SnapshotID: snapshotID, Paths: paths})
+}
+
+func (s *fakeServer) getCheckResults(w http.ResponseWriter, r *http.Request, params []string) {
+	checks, ok := s.checks[params[0]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	snapshotID := r.URL.Query().Get("snapshotId")
+	if snapshotID == "" {
+		latest := s.latestProcessedSnapshot(params[0])
+		if latest == nil {
+			http.Error(w, "network has no processed snapshot", http.StatusBadRequest)
+			return
+		}
+		snapshotID = latest.ID
+	}
+
+	results := forwardnetworks.CheckResults{SnapshotID: snapshotID, Checks: []forwardnetworks.CheckResult{}}
+	for _, check := range checks {
+		results.Checks = append(results.Checks, forwardnetworks.CheckResult{
+			Check:         *check,
+			NumViolations: len(s.violations[check.ID]),
+			Violations:    s.violations[check.ID],
+		})
+	}
+	sort.Slice(results.Checks, func(i, j int) bool {
+		return results.Checks[i].ID < results.Checks[j].ID
+	})
+	writeJSON(w, results)
+}
+
+func (s *fakeServer) createCheck(w http.ResponseWriter, r *http.Request, params []string) {
+	checks, ok := s.checks[params[0]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var check forwardnetworks.Check
+	if err := json.NewDecoder(r.Body).Decode(&check); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.nextID++
+	check.ID = "C-" + strconv.Itoa(s.nextID)
+	if check.Name == "" {
+		check.Name = check.CheckType + " check " + check.ID
+	}
+	if check.Priority == "" {
+		check.Priority = "NOT_SET"
+	}
+	check.Status = "PASS"
+	checks[check.ID] = &check
+	writeJSON(w, check)
+}
+
+func (s *fakeServer) getCheck(w http.ResponseWriter, r *http.Request, params []string) {
+	check, ok := s.checks[params[0]][params[1]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, check)
+}
+
+func (s *fakeServer) updateCheck(w http.ResponseWriter, r *http.Request, params []string) {
+	check, ok := s.checks[params[0]][params[1]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var update forwardnetworks.Check
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	update.ID = check.ID
+	update.Status = check.Status
+	if update.Name == "" {
+		update.Name = check.Name
+	}
+	*check = update
+	writeJSON(w, check)
+}
+
+func (s *fakeServer) deleteCheck(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.checks[params[0]][params[1]]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	delete(s.checks[params[0]], params[1])
+	delete(s.violations, params[1])
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *fakeServer) createDeviceCredential(w http.ResponseWriter, r *http.Request, params []string) {
+	credentials, ok := s.credentials[params[0]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var credential forwardnetworks.DeviceCredential
+	if err := json.NewDecoder(r.Body).Decode(&credential); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.nextID++
+	credential.ID = "D-" + strconv.Itoa(s.nextID)
+	credentials[credential.ID] = &credential
+	writeJSON(w, withoutSecrets(credential))
+}
+
+func (s *fakeServer) getDeviceCredential(w http.ResponseWriter, r *http.Request, params []string) {
+	credential, ok := s.credentials[params[0]][params[1]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, withoutSecrets(*credential))
+}
+
+// updateDeviceCredential replaces a credential. Its type cannot be changed.
+func (s *fakeServer) updateDeviceCredential(w http.ResponseWriter, r *http.Request, params []string) {
+	credential, ok := s.credentials[params[0]][params[1]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var update forwardnetworks.DeviceCredential
+	if err := json.NewDecoder(r.Body).Decode(&update); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if update.Type != credential.Type {
+		http.Error(w, "the credential type cannot be changed", http.StatusBadRequest)
+		return
+	}
+	update.ID = credential.ID
+	*credential = update
+	writeJSON(w, withoutSecrets(*credential))
+}
+
+func (s *fakeServer) deleteDeviceCredential(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.credentials[params[0]][params[1]]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	delete(s.credentials[params[0]], params[1])
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *fakeServer) getClassicDevices(w http.ResponseWriter, r *http.Request, params []string) {
+	devices, ok := s.devices[params[0]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	list := []forwardnetworks.ClassicDevice{}
+	for _, device := range devices {
+		list = append(list, *device)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	writeJSON(w, list)
+}
+
+// addClassicDevices adds a batch of devices, replacing the devices with the
+// same names.
+func (s *fakeServer) addClassicDevices(w http.ResponseWriter, r *http.Request, params []string) {
+	devices, ok := s.devices[params[0]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var batch []forwardnetworks.ClassicDevice
+	if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.batches++
+	for i := range batch {
+		device := batch[i]
+		if device.Port == 0 {
+			device.Port = 22
+		}
+		devices[device.Name] = &device
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteClassicDevices deletes a batch of devices. Names of devices that do
+// not exist are ignored.
+func (s *fakeServer) deleteClassicDevices(w http.ResponseWriter, r *http.Request, params []string) {
+	devices, ok := s.devices[params[0]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var batch forwardnetworks.ClassicDeviceNames
+	if err := json.NewDecoder(r.Body).Decode(&batch); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	s.batches++
+	for _, name := range batch.Names {
+		delete(devices, name)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *fakeServer) getClassicDevice(w http.ResponseWriter, r *http.Request, params []string) {
+	device, ok := s.devices[params[0]][params[1]]
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	writeJSON(w, device)
+}
+
+func (s *fakeServer) deleteClassicDevice(w http.ResponseWriter, r *http.Request, params []string) {
+	if _, ok := s.devices[params[0]][params[1]]; !ok {
+		http.NotFound(w, r)
+		return
+	}
+	delete(s.devices[params[0]], params[1])
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// withoutSecrets returns a credential as the API returns it, without its
+// secrets.
+func withoutSecrets(credential forwardnetworks.DeviceCredential) forwardnetworks.DeviceCredential {
+	credential.Password = ""
+	credential.PrivateKey = ""
+	credential.Passphrase = ""
+	credential.Community = ""
+	credential.Token = ""
+	return credential
+}
+
+// match reports whether the request has the given method and a path matching
+// pattern, where each "*" segment matches any single path segment. The values
+// of the wildcard segments are returned in order.
+func match(r *http.Request, method, pattern string) ([]string, bool) {
+	if r.Method != method {
+		return nil, false
+	}
+	want := strings.Split(strings.Trim(pattern, "/"), "/")
+	got := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	if len(want) != len(got) {
+		return nil, false
+	}
+	var params []string
+	for i := range want {
+		switch want[i] {
+		case "*":
+			params = append(params, got[i])
+		case got[i]:
+		default:
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,564 @@
+package forwardnetworks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// checkPriorities lists the priorities accepted by the Forward Networks API.
+var checkPriorities = []string{"NOT_SET", "LOW", "MEDIUM", "HIGH"}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &intentCheckResource{}
+	_ resource.ResourceWithConfigure      = &intentCheckResource{}
+	_ resource.ResourceWithModifyPlan     = &intentCheckResource{}
+	_ resource.ResourceWithImportState    = &intentCheckResource{}
+	_ resource.ResourceWithValidateConfig = &intentCheckResource{}
+)
+
+// NewIntentCheckResource is a helper function to simplify the provider implementation.
+func NewIntentCheckResource() resource.Resource {
+	return &intentCheckResource{}
+}
+
+// intentCheckResource is the resource implementation.
+type intentCheckResource struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// intentCheckResourceModel maps the resource schema data.
+type intentCheckResourceModel struct {
+	ID           types.String          `tfsdk:"id"`
+	NetworkID    types.String          `tfsdk:"network_id"`
+	Name         types.String          `tfsdk:"name"`
+	Priority     types.String          `tfsdk:"priority"`
+	Tags         types.Set             `tfsdk:"tags"`
+	Note         types.String          `tfsdk:"note"`
+	Status       types.String          `tfsdk:"status"`
+	Reachability *intentCheckPathModel `tfsdk:"reachability"`
+	Isolation    *intentCheckPathModel `tfsdk:"isolation"`
+	Existence    *intentCheckPathModel `tfsdk:"existence"`
+	Nqe          *intentCheckNqeModel  `tfsdk:"nqe"`
+}
+
+// intentCheckPathModel maps the traffic of a path-based check.
+type intentCheckPathModel struct {
+	Source           types.String `tfsdk:"source"`
+	Destination      types.String `tfsdk:"destination"`
+	Protocol         types.String `tfsdk:"protocol"`
+	DestinationPorts types.String `tfsdk:"destination_ports"`
+}
+
+// intentCheckNqeModel maps an NQE-based check.
+type intentCheckNqeModel struct {
+	QueryID    types.String `tfsdk:"query_id"`
+	Parameters types.String `tfsdk:"parameters"`
+}
+
+// Metadata returns the resource type name.
+func (r *intentCheckResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_intent_check"
+}
+
+// Schema defines the schema for the resource.
+func (r *intentCheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an intent verification check on a Forward Networks network. " +
+			"Exactly one of the reachability, isolation, existence or nqe blocks must be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the check.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The network ID the check applies to. Defaults to the default_network_id of the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the check. Generated by Forward Networks when omitted.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"priority": schema.StringAttribute{
+				Description: "Priority of the check: one of " + strings.Join(checkPriorities, ", ") + ". Defaults to NOT_SET.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("NOT_SET"),
+			},
+			"tags": schema.SetAttribute{
+				Description: "Tags of the check.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"note": schema.StringAttribute{
+				Description: "Note for the check.",
+				Optional:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the check on the latest processed snapshot, such as PASS or FAIL.",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"reachability": intentCheckPathBlock("Checks that the traffic is delivered to the destination."),
+			"isolation":    intentCheckPathBlock("Checks that the traffic cannot reach the destination."),
+			"existence":    intentCheckPathBlock("Checks that at least one path exists for the traffic."),
+			"nqe": schema.SingleNestedBlock{
+				Description: "Checks that an NQE library query returns no violations.",
+				Attributes: map[string]schema.Attribute{
+					"query_id": schema.StringAttribute{
+						Description: "ID of the NQE library query.",
+						Optional:    true,
+					},
+					"parameters": schema.StringAttribute{
+						Description: "JSON-encoded object of query parameters.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// intentCheckPathBlock returns the schema of a path-based check block.
+func intentCheckPathBlock(description string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"source": schema.StringAttribute{
+				Description: "IP address, subnet or host name the traffic originates from.",
+				Optional:    true,
+			},
+			"destination": schema.StringAttribute{
+				Description: "IP address, subnet or host name the traffic is sent to.",
+				Optional:    true,
+			},
+			"protocol": schema.StringAttribute{
+				Description: "IP protocol of the traffic, such as tcp, udp or icmp.",
+				Optional:    true,
+			},
+			"destination_ports": schema.StringAttribute{
+				Description: "Destination port or port range of the traffic, such as 443 or 8000-8080.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures exactly one check type is configured and that its
+// required attributes are set.
+func (r *intentCheckResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config intentCheckResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	configured := 0
+	for name, block := range map[string]*intentCheckPathModel{
+		"reachability": config.Reachability,
+		"isolation":    config.Isolation,
+		"existence":    config.Existence,
+	} {
+		if block == nil {
+			continue
+		}
+		configured++
+		if block.Source.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name).AtName("source"),
+				"Missing Check Source",
+				"The source attribute is required in the "+name+" block.",
+			)
+		}
+		if block.Destination.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name).AtName("destination"),
+				"Missing Check Destination",
+				"The destination attribute is required in the "+name+" block.",
+			)
+		}
+	}
+	if config.Nqe != nil {
+		configured++
+		if config.Nqe.QueryID.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("nqe").AtName("query_id"),
+				"Missing Check Query ID",
+				"The query_id attribute is required in the nqe block.",
+			)
+		}
+		if !config.Nqe.Parameters.IsNull() && !config.Nqe.Parameters.IsUnknown() {
+			var parameters map[string]any
+			if err := json.Unmarshal([]byte(config.Nqe.Parameters.ValueString()), &parameters); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("nqe").AtName("parameters"),
+					"Invalid NQE Check Parameters",
+					"The parameters value must be a JSON-encoded object: "+err.Error(),
+				)
+			}
+		}
+	}
+	if configured != 1 {
+		resp.Diagnostics.AddError(
+			"Invalid Intent Check Configuration",
+			"Exactly one of the reachability, isolation, existence or nqe blocks must be set.",
+		)
+	}
+
+	if !config.Priority.IsNull() && !config.Priority.IsUnknown() {
+		valid := false
+		for _, priority := range checkPriorities {
+			valid = valid || config.Priority.ValueString() == priority
+		}
+		if !valid {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("priority"),
+				"Invalid Check Priority",
+				"The priority must be one of "+strings.Join(checkPriorities, ", ")+".",
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *intentCheckResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.defaults = data.defaults
+}
+
+// ModifyPlan fills in the network ID from the default_network_id of the
+// provider when it is omitted, and replaces the check when its network
+// changes.
+func (r *intentCheckResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the check is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.defaults.planNetworkID(ctx, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *intentCheckResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan intentCheckResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	check, diags := plan.check(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new check
+	created, err := r.client.CreateCheck(plan.NetworkID.ValueString(), check)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Intent Check",
+			"Could not create check on network ID "+plan.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(plan.refresh(ctx, created)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *intentCheckResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state intentCheckResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed check value from Forward Networks
+	check, err := r.client.GetCheck(state.NetworkID.ValueString(), state.ID.ValueString())
+	if errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Intent Check",
+			"Could not read check ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite attributes with refreshed state
+	resp.Diagnostics.Append(state.refresh(ctx, check)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *intentCheckResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan intentCheckResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Generate API request body from plan
+	check, diags := plan.check(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing check
+	updated, err := r.client.UpdateCheck(plan.NetworkID.ValueString(), plan.ID.ValueString(), check)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Intent Check",
+			"Could not update check ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with the refreshed check
+	resp.Diagnostics.Append(plan.refresh(ctx, updated)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *intentCheckResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state intentCheckResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing check
+	err := r.client.DeleteCheck(state.NetworkID.ValueString(), state.ID.ValueString())
+	if err != nil && !errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Intent Check",
+			"Could not delete check ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing check using an import ID of the form
+// network_id/check_id.
+func (r *intentCheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	networkID, checkID, ok := strings.Cut(req.ID, "/")
+	if !ok || networkID == "" || checkID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format network_id/check_id, got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), networkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), checkID)...)
+}
+
+// check builds the API representation of the check from the model.
+func (m *intentCheckResourceModel) check(ctx context.Context) (forwardnetworks.Check, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	check := forwardnetworks.Check{
+		Name:     m.Name.ValueString(),
+		Note:     m.Note.ValueString(),
+		Priority: m.Priority.ValueString(),
+	}
+	if !m.Tags.IsNull() {
+		diags.Append(m.Tags.ElementsAs(ctx, &check.Tags, false)...)
+	}
+
+	switch {
+	case m.Reachability != nil:
+		check.CheckType = forwardnetworks.CheckTypeReachability
+		check.Path = m.Reachability.filter()
+	case m.Isolation != nil:
+		check.CheckType = forwardnetworks.CheckTypeIsolation
+		check.Path = m.Isolation.filter()
+	case m.Existence != nil:
+		check.CheckType = forwardnetworks.CheckTypeExistential
+		check.Path = m.Existence.filter()
+	case m.Nqe != nil:
+		check.CheckType = forwardnetworks.CheckTypeNqe
+		check.Nqe = &forwardnetworks.NqeCheckDefinition{
+			QueryID: m.Nqe.QueryID.ValueString(),
+		}
+		if !m.Nqe.Parameters.IsNull() {
+			if err := json.Unmarshal([]byte(m.Nqe.Parameters.ValueString()), &check.Nqe.Parameters); err != nil {
+				diags.AddAttributeError(
+					path.Root("nqe").AtName("parameters"),
+					"Invalid NQE Check Parameters",
+					"The parameters value must be a JSON-encoded object: "+err.Error(),
+				)
+			}
+		}
+	}
+
+	return check, diags
+}
+
+// refresh copies the API representation of a check into the model.
+func (m *intentCheckResourceModel) refresh(ctx context.Context, check *forwardnetworks.Check) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(check.ID)
+	m.Name = types.StringValue(check.Name)
+	m.Priority = types.StringValue(check.Priority)
+	m.Status = types.StringValue(check.Status)
+	m.Note = stringValueOrNull(check.Note)
+	if len(check.Tags) > 0 {
+		m.Tags, diags = types.SetValueFrom(ctx, types.StringType, check.Tags)
+	} else {
+		m.Tags = types.SetNull(types.StringType)
+	}
+
+	current := m.Nqe
+	m.Reachability, m.Isolation, m.Existence, m.Nqe = nil, nil, nil, nil
+	switch check.CheckType {
+	case forwardnetworks.CheckTypeReachability:
+		m.Reachability = newIntentCheckPathModel(check.Path)
+	case forwardnetworks.CheckTypeIsolation:
+		m.Isolation = newIntentCheckPathModel(check.Path)
+	case forwardnetworks.CheckTypeExistential:
+		m.Existence = newIntentCheckPathModel(check.Path)
+	case forwardnetworks.CheckTypeNqe:
+		m.Nqe = newIntentCheckNqeModel(check.Nqe, current)
+	default:
+		diags.AddError(
+			"Unsupported Intent Check Type",
+			"Check ID "+check.ID+" has type "+check.CheckType+", which is not supported by this resource.",
+		)
+	}
+
+	return diags
+}
+
+// newIntentCheckNqeModel returns the block for the API representation of an
+// NQE check. Parameters that are equal to the current ones are kept as they
+// are so that formatting differences in the JSON do not cause a diff.
+func newIntentCheckNqeModel(nqe *forwardnetworks.NqeCheckDefinition, current *intentCheckNqeModel) *intentCheckNqeModel {
+	if nqe == nil {
+		nqe = &forwardnetworks.NqeCheckDefinition{}
+	}
+	block := &intentCheckNqeModel{
+		QueryID:    types.StringValue(nqe.QueryID),
+		Parameters: types.StringNull(),
+	}
+	if len(nqe.Parameters) == 0 {
+		return block
+	}
+
+	if current != nil && !current.Parameters.IsNull() {
+		var parameters map[string]any
+		if json.Unmarshal([]byte(current.Parameters.ValueString()), &parameters) == nil && reflect.DeepEqual(parameters, nqe.Parameters) {
+			block.Parameters = current.Parameters
+			return block
+		}
+	}
+	if encoded, err := json.Marshal(nqe.Parameters); err == nil {
+		block.Parameters = types.StringValue(string(encoded))
+	}
+	return block
+}
+
+// filter returns the API representation of the traffic of a path-based check.
+func (m *intentCheckPathModel) filter() *forwardnetworks.CheckPathFilter {
+	return &forwardnetworks.CheckPathFilter{
+		Source:           m.Source.ValueString(),
+		Destination:      m.Destination.ValueString(),
+		Protocol:         m.Protocol.ValueString(),
+		DestinationPorts: m.DestinationPorts.ValueString(),
+	}
+}
+
+// newIntentCheckPathModel returns the block for the traffic of a path-based
+// check.
+func newIntentCheckPathModel(filter *forwardnetworks.CheckPathFilter) *intentCheckPathModel {
+	if filter == nil {
+		filter = &forwardnetworks.CheckPathFilter{}
+	}
+	return &intentCheckPathModel{
+		Source:           types.StringValue(filter.Source),
+		Destination:      types.StringValue(filter.Destination),
+		Protocol:         stringValueOrNull(filter.Protocol),
+		DestinationPorts: stringValueOrNull(filter.DestinationPorts),
+	}
+}
+
+// stringValueOrNull returns a null string for empty values, matching how
+// optional attributes that were omitted from the configuration are stored.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
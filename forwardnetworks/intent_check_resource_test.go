@@ -0,0 +1,141 @@
+package forwardnetworks
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIntentCheckResource(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("checks")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_intent_check" "test" {
+  network_id = "` + networkID + `"
+  name       = "web reachable"
+  priority   = "HIGH"
+  tags       = ["web", "pci"]
+
+  reachability {
+    source            = "10.0.0.0/24"
+    destination       = "10.1.0.10"
+    protocol          = "tcp"
+    destination_ports = "443"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_intent_check.test", "name", "web reachable"),
+					resource.TestCheckResourceAttr("forwardnetworks_intent_check.test", "priority", "HIGH"),
+					resource.TestCheckResourceAttr("forwardnetworks_intent_check.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("forwardnetworks_intent_check.test", "status", "PASS"),
+					resource.TestCheckResourceAttr("forwardnetworks_intent_check.test", "reachability.destination_ports", "443"),
+					resource.TestCheckResourceAttrSet("forwardnetworks_intent_check.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "forwardnetworks_intent_check.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccIntentCheckImportID("forwardnetworks_intent_check.test"),
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				PreConfig: func() {
+					srv.setCheckStatus(networkID, "web reachable", "FAIL")
+				},
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_intent_check" "test" {
+  network_id = "` + networkID + `"
+  name       = "web reachable"
+  note       = "Monitored by the web team"
+
+  nqe {
+    query_id   = "FQ_web"
+    parameters = jsonencode({ port = 443 })
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_intent_check.test", "priority", "NOT_SET"),
+					resource.TestCheckResourceAttr("forwardnetworks_intent_check.test", "note", "Monitored by the web team"),
+					resource.TestCheckResourceAttr("forwardnetworks_intent_check.test", "status", "FAIL"),
+					resource.TestCheckNoResourceAttr("forwardnetworks_intent_check.test", "tags"),
+					resource.TestCheckResourceAttr("forwardnetworks_intent_check.test", "nqe.query_id", "FQ_web"),
+					resource.TestCheckResourceAttr("forwardnetworks_intent_check.test", "nqe.parameters", `{"port":443}`),
+					testAccCheckIntentCheckType(srv, networkID, "forwardnetworks_intent_check.test", "NQE"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIntentCheckResource_multipleTypes(t *testing.T) {
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_intent_check" "test" {
+  network_id = "1"
+
+  reachability {
+    source      = "10.0.0.1"
+    destination = "10.0.0.2"
+  }
+
+  isolation {
+    source      = "10.0.0.1"
+    destination = "10.0.0.3"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile("Exactly one of the reachability, isolation, existence or nqe blocks"),
+			},
+		},
+	})
+}
+
+// testAccIntentCheckImportID returns the network_id/check_id import ID of a
+// check in state.
+func testAccIntentCheckImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		return rs.Primary.Attributes["network_id"] + "/" + rs.Primary.ID, nil
+	}
+}
+
+// testAccCheckIntentCheckType verifies the check stored by the fake server has
+// the expected type.
+func testAccCheckIntentCheckType(srv *fakeServer, networkID, resourceName, checkType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		check := srv.check(networkID, rs.Primary.ID)
+		if check == nil {
+			return fmt.Errorf("check %s does not exist", rs.Primary.ID)
+		}
+		if check.CheckType != checkType {
+			return fmt.Errorf("expected check type %q, got %q", checkType, check.CheckType)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,255 @@
+package forwardnetworks
+
+import (
+	"context"
+	"errors"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &networkExternalIdResource{}
+	_ resource.ResourceWithConfigure   = &networkExternalIdResource{}
+	_ resource.ResourceWithModifyPlan  = &networkExternalIdResource{}
+	_ resource.ResourceWithImportState = &networkExternalIdResource{}
+)
+
+// NewNetworkExternalIdResource is a helper function to simplify the provider implementation.
+func NewNetworkExternalIdResource() resource.Resource {
+	return &networkExternalIdResource{}
+}
+
+// networkExternalIdResource is the resource implementation.
+type networkExternalIdResource struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// networkExternalIdResourceModel maps the resource schema data.
+type networkExternalIdResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	NetworkID        types.String `tfsdk:"network_id"`
+	ExternalID       types.String `tfsdk:"external_id"`
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+}
+
+// Metadata returns the resource type name.
+func (r *networkExternalIdResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network_external_id"
+}
+
+// Schema defines the schema for the resource.
+func (r *networkExternalIdResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the external ID of a Forward Networks network, used in the trust policies of cloud accounts " +
+			"collected by the network. Destroying the resource leaves the external ID of the network unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The network ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The network whose external ID is managed. Defaults to the default_network_id of the provider. Changing it replaces the resource.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"external_id": schema.StringAttribute{
+				Description: "The external ID of the network. If omitted, the current external ID of the network is kept until rotation_triggers change.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"rotation_triggers": schema.MapAttribute{
+				Description: "Arbitrary values that rotate the external ID to a new value generated by Forward Networks when they change. " +
+					"Ignored when external_id is set.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *networkExternalIdResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.defaults = data.defaults
+}
+
+// ModifyPlan fills in the network ID from the default_network_id of the
+// provider when it is omitted, replaces the resource when its network
+// changes, and plans a new external ID when the rotation triggers change.
+func (r *networkExternalIdResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config networkExternalIdResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	networkID := r.defaults.planNetworkID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new resource reads or sets the external ID on create.
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state networkExternalIdResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A replacement reads or sets the external ID on create.
+	if !networkID.Equal(state.NetworkID) {
+		return
+	}
+
+	// Without a configured external ID, keep the current one unless the
+	// rotation triggers change.
+	if config.ExternalID.IsNull() {
+		externalID := state.ExternalID
+		if !config.RotationTriggers.Equal(state.RotationTriggers) {
+			externalID = types.StringUnknown()
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("external_id"), externalID)...)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *networkExternalIdResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan networkExternalIdResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the configured external ID, or adopt the current one
+	var externalID *forwardnetworks.ExternalId
+	var err error
+	if !plan.ExternalID.IsUnknown() {
+		externalID, err = r.client.SetExternalId(plan.NetworkID.ValueString(), plan.ExternalID.ValueString())
+	} else {
+		externalID, err = r.client.GetExternalId(plan.NetworkID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks External ID",
+			"Could not manage external ID of network ID "+plan.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.NetworkID.ValueString())
+	plan.ExternalID = types.StringValue(externalID.ExternalId)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *networkExternalIdResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state networkExternalIdResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed external ID from Forward Networks
+	externalID, err := r.client.GetExternalId(state.NetworkID.ValueString())
+	if errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Forward Networks External ID",
+			"Could not read external ID of network ID "+state.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(state.NetworkID.ValueString())
+	state.ExternalID = types.StringValue(externalID.ExternalId)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update sets or rotates the external ID and sets the updated Terraform
+// state on success.
+func (r *networkExternalIdResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state networkExternalIdResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	externalID := &forwardnetworks.ExternalId{ExternalId: state.ExternalID.ValueString()}
+	switch {
+	case plan.ExternalID.IsUnknown():
+		externalID, err = r.client.RotateExternalId(plan.NetworkID.ValueString())
+	case !plan.ExternalID.Equal(state.ExternalID):
+		externalID, err = r.client.SetExternalId(plan.NetworkID.ValueString(), plan.ExternalID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Forward Networks External ID",
+			"Could not update external ID of network ID "+plan.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ExternalID = types.StringValue(externalID.ExternalId)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state. The external ID of a
+// network cannot be removed, so it is left unchanged.
+func (r *networkExternalIdResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports the external ID of a network by the network ID.
+func (r *networkExternalIdResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,91 @@
+package forwardnetworks
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkExternalIdResource(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("external-id")
+
+	var rotated string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create adopts the current external ID
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_network_external_id" "test" {
+  network_id = "` + networkID + `"
+
+  rotation_triggers = {
+    account_id = "111111111111"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_network_external_id.test", "id", networkID),
+					resource.TestCheckResourceAttr("forwardnetworks_network_external_id.test", "external_id", "fwd-"+networkID+"-external"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "forwardnetworks_network_external_id.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotation_triggers"},
+			},
+			// Changing the rotation triggers rotates the external ID
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_network_external_id" "test" {
+  network_id = "` + networkID + `"
+
+  rotation_triggers = {
+    account_id = "222222222222"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					func(s *terraform.State) error {
+						rotated = s.RootModule().Resources["forwardnetworks_network_external_id.test"].Primary.Attributes["external_id"]
+						if rotated == "fwd-"+networkID+"-external" {
+							return fmt.Errorf("expected the external ID to be rotated")
+						}
+						return nil
+					},
+					testAccCheckExternalID(srv, networkID, func() string { return rotated }),
+				),
+			},
+			// Setting the external ID explicitly
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_network_external_id" "test" {
+  network_id  = "` + networkID + `"
+  external_id = "customer-a-trust"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_network_external_id.test", "external_id", "customer-a-trust"),
+					testAccCheckExternalID(srv, networkID, func() string { return "customer-a-trust" }),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccCheckExternalID verifies the fake server stores the expected external
+// ID for a network.
+func testAccCheckExternalID(srv *fakeServer, networkID string, expected func() string) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		if got := srv.externalID(networkID); got != expected() {
+			return fmt.Errorf("expected external ID %q, got %q", expected(), got)
+		}
+		return nil
+	}
+}
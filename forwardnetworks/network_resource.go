@@ -1,272 +1,378 @@
-package forwardnetworks
-
-import (
-	"context"
-	"strconv"
-	"time"
-
-	"github.com/forwardnetworks/forwardnetworks-client-go"
-	"github.com/hashicorp/terraform-plugin-framework/path"
-	"github.com/hashicorp/terraform-plugin-framework/resource"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-)
-
-// Ensure the implementation satisfies the expected interfaces.
-var (
-	_ resource.Resource                = &networkResource{}
-	_ resource.ResourceWithConfigure   = &networkResource{}
-	_ resource.ResourceWithImportState = &networkResource{}
-)
-
-// NewNetworkResource is a helper function to simplify the provider implementation.
-func NewNetworkResource() resource.Resource {
-	return &networkResource{}
-}
-
-// networkResource is the resource implementation.
-type networkResource struct {
-	client *forwardnetworks.Client
-}
-
-// networkResourceModel maps the resource schema data.
-type networkResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-    ParentID  types.String `tfsdk:"parentId"`
-    Name      types.String `tfsdk:"name"`
-    OrgID     types.String `tfsdk:"orgId"`
-    Creator   types.String `tfsdk:"creator"`
-    CreatorID types.String `tfsdk:"creatorId"`
-    CreatedAt types.Int64  `tfsdk:"createdAt"`
-    Note      types.String `tfsdk:"note"`
-}
-
-// Metadata returns the data source type name.
-func (r *networkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network"
-}
-
-// Schema defines the schema for the data source.
-func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Manages an network.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Numeric identifier of the network.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
-			"name": schema.StringAttribute{
-				Description: "Name of the network.",
-				Required:    true,
-			},
-			"note": schema.StringAttribute{
-				Description: "Note for a network.",
-				Optional:    true,
-			},
-}
-
-// Configure adds the provider configured client to the data source.
-func (r *networkResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	r.client = req.ProviderData.(*forwardnetworks.Client)
-}
-
-// Create creates the resource and sets the initial Terraform state.
-func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	// Retrieve values from plan
-	var plan networkResourceModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Generate API request body from plan
-	var items []forwardnetworks.NetworkItem
-	for _, item := range plan.Items {
-		items = append(items, forwardnetworks.NetworkItem{
-			Coffee: forwardnetworks.Coffee{
-				ID: int(item.Coffee.ID.ValueInt64()),
-			},
-			Quantity: int(item.Quantity.ValueInt64()),
-		})
-	}
-
-	// Create new network
-	network, err := r.client.CreateNetwork(items)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating network",
-			"Could not create network, unexpected error: "+err.Error(),
-		)
-		return
-	}
-
-	// Map response body to schema and populate Computed attribute values
-	plan.ID = types.StringValue(strconv.Itoa(network.ID))
-	for itemIndex, item := range network.Items {
-		plan.Items[itemIndex] = networkItemModel{
-			Coffee: networkItemCoffeeModel{
-				ID:          types.Int64Value(int64(item.Coffee.ID)),
-				Name:        types.StringValue(item.Coffee.Name),
-				Teaser:      types.StringValue(item.Coffee.Teaser),
-				Description: types.StringValue(item.Coffee.Description),
-				Price:       types.Float64Value(item.Coffee.Price),
-				Image:       types.StringValue(item.Coffee.Image),
-			},
-			Quantity: types.Int64Value(int64(item.Quantity)),
-		}
-	}
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
-
-	// Set state to fully populated data
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-}
-
-// Read refreshes the Terraform state with the latest data.
-func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	// Get current state
-	var state networkResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Get refreshed network value from forwardnetworks
-	network, err := r.client.GetNetwork(state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading forwardnetworks Network",
-			"Could not read forwardnetworks network ID "+state.ID.ValueString()+": "+err.Error(),
-		)
-		return
-	}
-
-	// Overwrite items with refreshed state
-	state.Items = []networkItemModel{}
-	for _, item := range network.Items {
-		state.Items = append(state.Items, networkItemModel{
-			Coffee: networkItemCoffeeModel{
-				ID:          types.Int64Value(int64(item.Coffee.ID)),
-				Name:        types.StringValue(item.Coffee.Name),
-				Teaser:      types.StringValue(item.Coffee.Teaser),
-				Description: types.StringValue(item.Coffee.Description),
-				Price:       types.Float64Value(item.Coffee.Price),
-				Image:       types.StringValue(item.Coffee.Image),
-			},
-			Quantity: types.Int64Value(int64(item.Quantity)),
-		})
-	}
-
-	// Set refreshed state
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-}
-
-// Update updates the resource and sets the updated Terraform state on success.
-func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Retrieve values from plan
-	var plan networkResourceModel
-	diags := req.Plan.Get(ctx, &plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Generate API request body from plan
-	var forwardnetworksItems []forwardnetworks.NetworkItem
-	for _, item := range plan.Items {
-		forwardnetworksItems = append(forwardnetworksItems, forwardnetworks.NetworkItem{
-			Coffee: forwardnetworks.Coffee{
-				ID: int(item.Coffee.ID.ValueInt64()),
-			},
-			Quantity: int(item.Quantity.ValueInt64()),
-		})
-	}
-
-	// Update existing network
-	_, err := r.client.UpdateNetwork(plan.ID.ValueString(), forwardnetworksItems)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Updating forwardnetworks Network",
-			"Could not update network, unexpected error: "+err.Error(),
-		)
-		return
-	}
-
-	// Fetch updated items from GetNetwork as UpdateNetwork items are not
-	// populated.
-	network, err := r.client.GetNetwork(plan.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Reading forwardnetworks Network",
-			"Could not read forwardnetworks network ID "+plan.ID.ValueString()+": "+err.Error(),
-		)
-		return
-	}
-
-	// Update resource state with updated items and timestamp
-	plan.Items = []networkItemModel{}
-	for _, item := range network.Items {
-		plan.Items = append(plan.Items, networkItemModel{
-			Coffee: networkItemCoffeeModel{
-				ID:          types.Int64Value(int64(item.Coffee.ID)),
-				Name:        types.StringValue(item.Coffee.Name),
-				Teaser:      types.StringValue(item.Coffee.Teaser),
-				Description: types.StringValue(item.Coffee.Description),
-				Price:       types.Float64Value(item.Coffee.Price),
-				Image:       types.StringValue(item.Coffee.Image),
-			},
-			Quantity: types.Int64Value(int64(item.Quantity)),
-		})
-	}
-	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
-
-	diags = resp.State.Set(ctx, plan)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-}
-
-// Delete deletes the resource and removes the Terraform state on success.
-func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// Retrieve values from state
-	var state networkResourceModel
-	diags := req.State.Get(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	// Delete existing network
-	err := r.client.DeleteNetwork(state.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error Deleting forwardnetworks Network",
-			"Could not delete network, unexpected error: "+err.Error(),
-		)
-		return
-	}
-}
-
-func (r *networkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
-}
\ No newline at end of file
+package forwardnetworks
+
+import (
+	"context"
+	"errors"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &networkResource{}
+	_ resource.ResourceWithConfigure      = &networkResource{}
+	_ resource.ResourceWithImportState    = &networkResource{}
+	_ resource.ResourceWithValidateConfig = &networkResource{}
+)
+
+// NewNetworkResource is a helper function to simplify the provider implementation.
+func NewNetworkResource() resource.Resource {
+	return &networkResource{}
+}
+
+// networkResource is the resource implementation.
+type networkResource struct {
+	client *forwardnetworks.Client
+}
+
+// networkResourceModel maps the resource schema data.
+type networkResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	ParentID   types.String `tfsdk:"parent_id"`
+	Name       types.String `tfsdk:"name"`
+	OrgID      types.String `tfsdk:"org_id"`
+	Creator    types.String `tfsdk:"creator"`
+	CreatorID  types.String `tfsdk:"creator_id"`
+	CreatedAt  types.Int64  `tfsdk:"created_at"`
+	Note       types.String `tfsdk:"note"`
+	Devices    types.Set    `tfsdk:"devices"`
+	DeviceTags types.Set    `tfsdk:"device_tags"`
+}
+
+// Metadata returns the resource type name.
+func (r *networkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_network"
+}
+
+// Schema defines the schema for the resource.
+func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a Forward Networks network, or a workspace network derived from a subset of the devices of a parent network.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Numeric identifier of the network.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the network.",
+				Required:    true,
+			},
+			"note": schema.StringAttribute{
+				Description: "Note for a network.",
+				Optional:    true,
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "Identifier of the parent network. When set, a workspace network is created from the devices of the parent network. Changing it replaces the network.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"devices": schema.SetAttribute{
+				Description: "Names of the devices of the parent network to include in the workspace network. Requires parent_id. " +
+					"If neither devices nor device_tags is set, all devices of the parent network are included. Changing it replaces the network.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"device_tags": schema.SetAttribute{
+				Description: "Include the devices of the parent network with any of these tags in the workspace network. Requires parent_id. Changing it replaces the network.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"org_id": schema.StringAttribute{
+				Description: "Identifier of the organization owning the network.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creator": schema.StringAttribute{
+				Description: "Username of the network creator.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creator_id": schema.StringAttribute{
+				Description: "Identifier of the network creator.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Creation time of the network, in milliseconds since the Unix epoch.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures a device selection is only configured for workspace
+// networks.
+func (r *networkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config networkResourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.ParentID.IsNull() {
+		return
+	}
+
+	for name, value := range map[string]types.Set{
+		"devices":     config.Devices,
+		"device_tags": config.DeviceTags,
+	} {
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing Parent Network",
+				"The "+name+" attribute selects devices of a parent network and requires parent_id to be set.",
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *networkResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerData).client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan networkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ParentID.IsNull() {
+		r.createWorkspace(ctx, &plan, resp)
+		return
+	}
+
+	// Create new network
+	network, err := r.client.CreateNetwork(plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Network",
+			"Could not create network, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	note := plan.Note
+	plan.refresh(network)
+
+	// The create endpoint only accepts a name, so the note is set with a
+	// follow-up update. The network is saved first, so it is tracked by
+	// Terraform even if the update fails.
+	if !note.IsNull() {
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		network, err = r.client.UpdateNetwork(plan.ID.ValueString(), forwardnetworks.Network{
+			Name: plan.Name.ValueString(),
+			Note: note.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Creating Forward Networks Network",
+				"Could not set note on network ID "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		plan.refresh(network)
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// createWorkspace creates a workspace network of the planned parent network
+// and sets the initial Terraform state.
+func (r *networkResource) createWorkspace(ctx context.Context, plan *networkResourceModel, resp *resource.CreateResponse) {
+	workspace := forwardnetworks.WorkspaceNetwork{
+		Name: plan.Name.ValueString(),
+		Note: plan.Note.ValueString(),
+	}
+	if !plan.Devices.IsNull() {
+		resp.Diagnostics.Append(plan.Devices.ElementsAs(ctx, &workspace.Devices, false)...)
+	}
+	if !plan.DeviceTags.IsNull() {
+		resp.Diagnostics.Append(plan.DeviceTags.ElementsAs(ctx, &workspace.DeviceTags, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	network, err := r.client.CreateWorkspaceNetwork(plan.ParentID.ValueString(), workspace)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Network",
+			"Could not create workspace network of network ID "+plan.ParentID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.refresh(network)
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state networkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed network value from Forward Networks
+	network, err := r.client.GetNetwork(state.ID.ValueString())
+	if errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Forward Networks Network",
+			"Could not read network ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite attributes with refreshed state
+	state.refresh(network)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan networkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing network
+	network, err := r.client.UpdateNetwork(plan.ID.ValueString(), forwardnetworks.Network{
+		Name: plan.Name.ValueString(),
+		Note: plan.Note.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Forward Networks Network",
+			"Could not update network ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with the refreshed network
+	plan.refresh(network)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *networkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state networkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing network
+	err := r.client.DeleteNetwork(state.ID.ValueString())
+	if err != nil && !errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Forward Networks Network",
+			"Could not delete network ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing network by its numeric identifier.
+func (r *networkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// refresh copies the API representation of a network into the model. An
+// empty note or parent is stored as null so that networks without one do not
+// produce a diff against configurations that omit it. The device selection
+// of workspace networks is not returned by the API and is left unchanged.
+func (m *networkResourceModel) refresh(network *forwardnetworks.Network) {
+	m.ID = types.StringValue(network.ID)
+	m.Name = types.StringValue(network.Name)
+	if network.ParentID != "" {
+		m.ParentID = types.StringValue(network.ParentID)
+	} else {
+		m.ParentID = types.StringNull()
+	}
+	m.OrgID = types.StringValue(network.OrgID)
+	m.Creator = types.StringValue(network.Creator)
+	m.CreatorID = types.StringValue(network.CreatorID)
+	m.CreatedAt = types.Int64Value(network.CreatedAt)
+	if network.Note != "" {
+		m.Note = types.StringValue(network.Note)
+	} else {
+		m.Note = types.StringNull()
+	}
+}
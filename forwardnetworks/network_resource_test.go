@@ -0,0 +1,223 @@
+package forwardnetworks
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNetworkResource(t *testing.T) {
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkDestroyed(srv),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_network" "test" {
+  name = "tenant-a"
+  note = "created"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_network.test", "name", "tenant-a"),
+					resource.TestCheckResourceAttr("forwardnetworks_network.test", "note", "created"),
+					resource.TestCheckResourceAttr("forwardnetworks_network.test", "creator", testUsername),
+					resource.TestCheckResourceAttrSet("forwardnetworks_network.test", "id"),
+					resource.TestCheckResourceAttrSet("forwardnetworks_network.test", "org_id"),
+					resource.TestCheckResourceAttrSet("forwardnetworks_network.test", "creator_id"),
+					resource.TestCheckResourceAttrSet("forwardnetworks_network.test", "created_at"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "forwardnetworks_network.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_network" "test" {
+  name = "tenant-a-renamed"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_network.test", "name", "tenant-a-renamed"),
+					resource.TestCheckNoResourceAttr("forwardnetworks_network.test", "note"),
+					testAccCheckNetworkName(srv, "forwardnetworks_network.test", "tenant-a-renamed"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkResource_workspace(t *testing.T) {
+	srv := newFakeServer(t)
+	parentID := srv.addNetwork("production")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckNetworkDestroyed(srv),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_network" "test" {
+  name        = "team-a"
+  note        = "Team A devices"
+  parent_id   = "` + parentID + `"
+  devices     = ["edge-1", "edge-2"]
+  device_tags = ["team-a"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_network.test", "parent_id", parentID),
+					resource.TestCheckResourceAttr("forwardnetworks_network.test", "note", "Team A devices"),
+					resource.TestCheckResourceAttr("forwardnetworks_network.test", "devices.#", "2"),
+					resource.TestCheckResourceAttr("forwardnetworks_network.test", "device_tags.#", "1"),
+					testAccCheckWorkspaceDevices(srv, "forwardnetworks_network.test", "edge-1", "edge-2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "forwardnetworks_network.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"devices", "device_tags"},
+			},
+			// Changing the device selection replaces the network
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_network" "test" {
+  name      = "team-a"
+  note      = "Team A devices"
+  parent_id = "` + parentID + `"
+  devices   = ["edge-1"]
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forwardnetworks_network.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_network.test", "devices.#", "1"),
+					resource.TestCheckNoResourceAttr("forwardnetworks_network.test", "device_tags"),
+					testAccCheckWorkspaceDevices(srv, "forwardnetworks_network.test", "edge-1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNetworkResource_devicesWithoutParent(t *testing.T) {
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_network" "test" {
+  name    = "team-a"
+  devices = ["edge-1"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Missing Parent Network`),
+			},
+		},
+	})
+}
+
+func TestAccNetworkResource_noteUpdateFails(t *testing.T) {
+	srv := newFakeServer(t)
+	srv.failRoute(http.MethodPatch, "/api/networks/*")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// The network created before the failed update is tracked in the
+		// state, so it is destroyed rather than left behind.
+		CheckDestroy: func(_ *terraform.State) error {
+			if n := srv.networkCount(); n != 0 {
+				return fmt.Errorf("expected no networks, got %d", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_network" "test" {
+  name = "tenant-a"
+  note = "created"
+}
+`,
+				ExpectError: regexp.MustCompile(`Could not set note on network ID`),
+			},
+		},
+	})
+}
+
+// testAccCheckWorkspaceDevices verifies the workspace network was created
+// with the expected device selection.
+func testAccCheckWorkspaceDevices(srv *fakeServer, resourceName string, devices ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		workspace := srv.workspace(rs.Primary.ID)
+		got := append([]string(nil), workspace.Devices...)
+		sort.Strings(got)
+		if strings.Join(got, ",") != strings.Join(devices, ",") {
+			return fmt.Errorf("expected workspace devices %v, got %v", devices, got)
+		}
+		return nil
+	}
+}
+
+// testAccCheckNetworkName verifies the network stored by the fake server has
+// the expected name.
+func testAccCheckNetworkName(srv *fakeServer, resourceName, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		network := srv.network(rs.Primary.ID)
+		if network == nil {
+			return fmt.Errorf("network %s does not exist", rs.Primary.ID)
+		}
+		if network.Name != name {
+			return fmt.Errorf("expected network name %q, got %q", name, network.Name)
+		}
+		return nil
+	}
+}
+
+// testAccCheckNetworkDestroyed verifies every network in the final state has
+// been removed from the fake server.
+func testAccCheckNetworkDestroyed(srv *fakeServer) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "forwardnetworks_network" {
+				continue
+			}
+			if srv.network(rs.Primary.ID) != nil {
+				return fmt.Errorf("network %s still exists", rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
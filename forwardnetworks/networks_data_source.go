@@ -0,0 +1,221 @@
+package forwardnetworks
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &networksDataSource{}
+	_ datasource.DataSourceWithConfigure      = &networksDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &networksDataSource{}
+)
+
+// NewNetworksDataSource is a helper function to simplify the provider implementation.
+func NewNetworksDataSource() datasource.DataSource {
+	return &networksDataSource{}
+}
+
+// networksDataSource is the data source implementation.
+type networksDataSource struct {
+	client *forwardnetworks.Client
+}
+
+// networksDataSourceModel maps the data source schema data.
+type networksDataSourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	NameRegex types.String   `tfsdk:"name_regex"`
+	ParentID  types.String   `tfsdk:"parent_id"`
+	Networks  []networkModel `tfsdk:"networks"`
+}
+
+// networkModel maps network data.
+type networkModel struct {
+	ID        types.String `tfsdk:"id"`
+	ParentID  types.String `tfsdk:"parent_id"`
+	Name      types.String `tfsdk:"name"`
+	OrgID     types.String `tfsdk:"org_id"`
+	Creator   types.String `tfsdk:"creator"`
+	CreatorID types.String `tfsdk:"creator_id"`
+	CreatedAt types.Int64  `tfsdk:"created_at"`
+	Note      types.String `tfsdk:"note"`
+}
+
+// Metadata returns the data source type name.
+func (d *networksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks"
+}
+
+// Schema defines the schema for the data source.
+func (d *networksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the Forward Networks networks visible to the account.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return networks with exactly this name.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only return networks whose name matches this regular expression.",
+				Optional:    true,
+			},
+			"parent_id": schema.StringAttribute{
+				Description: "Only return workspace networks of the network with this ID.",
+				Optional:    true,
+			},
+			"networks": schema.ListNestedAttribute{
+				Description: "The networks matching the filters, ordered by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Numeric identifier of the network.",
+							Computed:    true,
+						},
+						"parent_id": schema.StringAttribute{
+							Description: "Identifier of the parent network, for workspace networks. Null for other networks.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the network.",
+							Computed:    true,
+						},
+						"org_id": schema.StringAttribute{
+							Description: "Identifier of the organization owning the network.",
+							Computed:    true,
+						},
+						"creator": schema.StringAttribute{
+							Description: "Username of the network creator.",
+							Computed:    true,
+						},
+						"creator_id": schema.StringAttribute{
+							Description: "Identifier of the network creator.",
+							Computed:    true,
+						},
+						"created_at": schema.Int64Attribute{
+							Description: "Creation time of the network, in milliseconds since the Unix epoch.",
+							Computed:    true,
+						},
+						"note": schema.StringAttribute{
+							Description: "Note attached to the network.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures name_regex is a valid regular expression.
+func (d *networksDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config networksDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.NameRegex.IsNull() || config.NameRegex.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(config.NameRegex.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name_regex"),
+			"Invalid Network Name Regular Expression",
+			"The name_regex value must be a valid regular expression: "+err.Error(),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *networksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerData).client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *networksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state networksDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		nameRegex = regexp.MustCompile(state.NameRegex.ValueString())
+	}
+
+	networks, err := d.client.GetNetworks()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Forward Networks Networks",
+			err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(networks, func(i, j int) bool {
+		if networks[i].Name != networks[j].Name {
+			return networks[i].Name < networks[j].Name
+		}
+		return networks[i].ID < networks[j].ID
+	})
+
+	state.Networks = []networkModel{}
+	for i := range networks {
+		network := &networks[i]
+		if !state.Name.IsNull() && network.Name != state.Name.ValueString() {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(network.Name) {
+			continue
+		}
+		if !state.ParentID.IsNull() && network.ParentID != state.ParentID.ValueString() {
+			continue
+		}
+
+		var model networkResourceModel
+		model.refresh(network)
+		state.Networks = append(state.Networks, networkModel{
+			ID:        model.ID,
+			ParentID:  model.ParentID,
+			Name:      model.Name,
+			OrgID:     model.OrgID,
+			Creator:   model.Creator,
+			CreatorID: model.CreatorID,
+			CreatedAt: model.CreatedAt,
+			Note:      model.Note,
+		})
+	}
+
+	state.ID = types.StringValue("networks")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
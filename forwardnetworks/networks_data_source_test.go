@@ -0,0 +1,72 @@
+package forwardnetworks
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNetworksDataSource(t *testing.T) {
+	srv := newFakeServer(t)
+	production := srv.addNetwork("production")
+	staging := srv.addNetwork("staging")
+	workspace := srv.addWorkspaceNetwork(production, "production-workspace")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_networks" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.test", "networks.#", "3"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.test", "networks.0.id", production),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.test", "networks.0.name", "production"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.test", "networks.0.org_id", "101"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.test", "networks.0.creator", testUsername),
+					resource.TestCheckResourceAttrSet("data.forwardnetworks_networks.test", "networks.0.created_at"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.test", "networks.1.id", workspace),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.test", "networks.1.parent_id", production),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.test", "networks.2.id", staging),
+				),
+			},
+			// Filter testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_networks" "by_name" {
+  name = "staging"
+}
+
+data "forwardnetworks_networks" "by_regex" {
+  name_regex = "^prod"
+}
+
+data "forwardnetworks_networks" "by_parent" {
+  parent_id = "` + production + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.by_name", "networks.#", "1"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.by_name", "networks.0.id", staging),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.by_regex", "networks.#", "2"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.by_regex", "networks.0.id", production),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.by_regex", "networks.1.id", workspace),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.by_parent", "networks.#", "1"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_networks.by_parent", "networks.0.id", workspace),
+				),
+			},
+			// Validation testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_networks" "test" {
+  name_regex = "("
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Network Name Regular Expression`),
+			},
+		},
+	})
+}
@@ -0,0 +1,253 @@
+package forwardnetworks
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &nqeQueryDataSource{}
+	_ datasource.DataSourceWithConfigure      = &nqeQueryDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &nqeQueryDataSource{}
+)
+
+// NewNqeQueryDataSource is a helper function to simplify the provider implementation.
+func NewNqeQueryDataSource() datasource.DataSource {
+	return &nqeQueryDataSource{}
+}
+
+// nqeQueryDataSource is the data source implementation.
+type nqeQueryDataSource struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// nqeQueryDataSourceModel maps the data source schema data.
+type nqeQueryDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	NetworkID  types.String `tfsdk:"network_id"`
+	SnapshotID types.String `tfsdk:"snapshot_id"`
+	Query      types.String `tfsdk:"query"`
+	QueryID    types.String `tfsdk:"query_id"`
+	Parameters types.String `tfsdk:"parameters"`
+	Limit      types.Int64  `tfsdk:"limit"`
+	Offset     types.Int64  `tfsdk:"offset"`
+	Rows       types.List   `tfsdk:"rows"`
+	RowCount   types.Int64  `tfsdk:"row_count"`
+	ResultJSON types.String `tfsdk:"result_json"`
+}
+
+// Metadata returns the data source type name.
+func (d *nqeQueryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nqe_query"
+}
+
+// Schema defines the schema for the data source.
+func (d *nqeQueryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Runs a Network Query Engine (NQE) query against a Forward Networks snapshot.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The network ID to query. Defaults to the default_network_id of the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "The snapshot ID to query. May also be latest or latestProcessed. Defaults to the default_snapshot of the provider, or the latest processed snapshot of the network; once read, holds the snapshot that was queried.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"query": schema.StringAttribute{
+				Description: "Source text of the NQE query to run. Exactly one of query or query_id must be set.",
+				Optional:    true,
+			},
+			"query_id": schema.StringAttribute{
+				Description: "ID of an NQE library query to run. Exactly one of query or query_id must be set.",
+				Optional:    true,
+			},
+			"parameters": schema.StringAttribute{
+				Description: "JSON-encoded object of query parameters, for example jsonencode({ deviceName = \"core-1\" }).",
+				Optional:    true,
+			},
+			"limit": schema.Int64Attribute{
+				Description: "Maximum number of rows to return.",
+				Optional:    true,
+			},
+			"offset": schema.Int64Attribute{
+				Description: "Number of rows to skip before returning results.",
+				Optional:    true,
+			},
+			"rows": schema.ListAttribute{
+				Description: "The rows returned by the query. Values that are not strings are JSON-encoded.",
+				Computed:    true,
+				ElementType: types.MapType{ElemType: types.StringType},
+			},
+			"row_count": schema.Int64Attribute{
+				Description: "The number of rows returned by the query.",
+				Computed:    true,
+			},
+			"result_json": schema.StringAttribute{
+				Description: "The rows returned by the query as a JSON-encoded list, preserving value types.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures exactly one of query and query_id is configured.
+func (d *nqeQueryDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config nqeQueryDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Query.IsUnknown() || config.QueryID.IsUnknown() {
+		return
+	}
+
+	if config.Query.IsNull() == config.QueryID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("query"),
+			"Invalid NQE Query Configuration",
+			"Exactly one of query or query_id must be set.",
+		)
+	}
+
+	if !config.Parameters.IsNull() && !config.Parameters.IsUnknown() {
+		var parameters map[string]any
+		if err := json.Unmarshal([]byte(config.Parameters.ValueString()), &parameters); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parameters"),
+				"Invalid NQE Query Parameters",
+				"The parameters value must be a JSON-encoded object: "+err.Error(),
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *nqeQueryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.defaults = data.defaults
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *nqeQueryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nqeQueryDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.NetworkID = d.defaults.resolveNetworkID(state.NetworkID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	snapshotID := d.defaults.resolveSnapshotID(d.client, state.NetworkID.ValueString(), state.SnapshotID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := forwardnetworks.NqeQuery{
+		Query:   state.Query.ValueString(),
+		QueryID: state.QueryID.ValueString(),
+	}
+	if !state.Parameters.IsNull() {
+		if err := json.Unmarshal([]byte(state.Parameters.ValueString()), &query.Parameters); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("parameters"),
+				"Invalid NQE Query Parameters",
+				"The parameters value must be a JSON-encoded object: "+err.Error(),
+			)
+			return
+		}
+	}
+	if !state.Limit.IsNull() || !state.Offset.IsNull() {
+		query.QueryOptions = &forwardnetworks.NqeQueryOptions{
+			Limit:  int(state.Limit.ValueInt64()),
+			Offset: int(state.Offset.ValueInt64()),
+		}
+	}
+
+	result, err := d.client.RunNqeQuery(state.NetworkID.ValueString(), snapshotID, query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Run NQE Query",
+			"Could not run NQE query on network ID "+state.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	rows := make([]map[string]string, 0, len(result.Items))
+	for _, item := range result.Items {
+		row := make(map[string]string, len(item))
+		for column, value := range item {
+			row[column] = nqeValueString(value)
+		}
+		rows = append(rows, row)
+	}
+
+	resultJSON, err := json.Marshal(result.Items)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Encode NQE Query Result",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Rows, diags = types.ListValueFrom(ctx, types.MapType{ElemType: types.StringType}, rows)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.RowCount = types.Int64Value(int64(len(rows)))
+	state.ResultJSON = types.StringValue(string(resultJSON))
+	state.SnapshotID = types.StringValue(result.SnapshotID)
+	state.ID = types.StringValue(result.SnapshotID)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// nqeValueString converts an NQE result value to a string, JSON-encoding
+// anything that is not already a string. Null values become empty strings.
+func nqeValueString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		encoded, err := json.Marshal(v)
+		if err != nil {
+			return ""
+		}
+		return string(encoded)
+	}
+}
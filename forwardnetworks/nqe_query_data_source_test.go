@@ -0,0 +1,100 @@
+package forwardnetworks
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+const testNqeQuery = `foreach d in network.devices select { name: d.name, ports: length(d.interfaces) }`
+
+func TestAccNqeQueryDataSource(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("nqe")
+	snapshotID := srv.addSnapshot(networkID, snapshotStateProcessed, time.Now())
+	rows := []map[string]any{
+		{"name": "core-1", "ports": 48},
+		{"name": "core-2", "ports": 24},
+		{"name": "edge-1", "ports": nil},
+	}
+	srv.setNqeResult(testNqeQuery, rows)
+	srv.setNqeResult("FQ_devices", rows)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Inline query testing
+			{
+				Config: srv.providerConfig() + fmt.Sprintf(`
+data "forwardnetworks_nqe_query" "test" {
+  network_id = %q
+  query      = %q
+}
+`, networkID, testNqeQuery),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_nqe_query.test", "snapshot_id", snapshotID),
+					resource.TestCheckResourceAttr("data.forwardnetworks_nqe_query.test", "row_count", "3"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_nqe_query.test", "rows.0.name", "core-1"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_nqe_query.test", "rows.0.ports", "48"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_nqe_query.test", "rows.2.ports", ""),
+					resource.TestCheckResourceAttr("data.forwardnetworks_nqe_query.test", "result_json",
+						`[{"name":"core-1","ports":48},{"name":"core-2","ports":24},{"name":"edge-1","ports":null}]`),
+				),
+			},
+			// Library query with parameters and pagination testing
+			{
+				Config: srv.providerConfig() + fmt.Sprintf(`
+data "forwardnetworks_nqe_query" "test" {
+  network_id  = %q
+  snapshot_id = %q
+  query_id    = "FQ_devices"
+  parameters  = jsonencode({ minPorts = 10 })
+  limit       = 1
+  offset      = 1
+}
+`, networkID, snapshotID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_nqe_query.test", "row_count", "1"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_nqe_query.test", "rows.0.name", "core-2"),
+					testAccCheckNqeParameter(srv, "minPorts", float64(10)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNqeQueryDataSource_queryAndQueryID(t *testing.T) {
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_nqe_query" "test" {
+  network_id = "1"
+  query      = "foreach d in network.devices select { name: d.name }"
+  query_id   = "FQ_devices"
+}
+`,
+				ExpectError: regexp.MustCompile("Exactly one of query or query_id must be set"),
+			},
+		},
+	})
+}
+
+// testAccCheckNqeParameter verifies the last NQE query received by the fake
+// server carried the expected parameter value.
+func testAccCheckNqeParameter(srv *fakeServer, name string, value any) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		got := srv.lastNqeQuery().Parameters[name]
+		if got != value {
+			return fmt.Errorf("expected NQE parameter %s to be %v, got %v", name, value, got)
+		}
+		return nil
+	}
+}
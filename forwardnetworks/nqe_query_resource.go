@@ -0,0 +1,327 @@
+package forwardnetworks
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &nqeQueryResource{}
+	_ resource.ResourceWithConfigure   = &nqeQueryResource{}
+	_ resource.ResourceWithImportState = &nqeQueryResource{}
+)
+
+// NewNqeQueryResource is a helper function to simplify the provider implementation.
+func NewNqeQueryResource() resource.Resource {
+	return &nqeQueryResource{}
+}
+
+// nqeQueryResource is the resource implementation.
+type nqeQueryResource struct {
+	client *forwardnetworks.Client
+}
+
+// nqeQueryResourceModel maps the resource schema data.
+type nqeQueryResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Path          types.String `tfsdk:"path"`
+	Source        types.String `tfsdk:"source"`
+	Description   types.String `tfsdk:"description"`
+	Committed     types.Bool   `tfsdk:"committed"`
+	CommitMessage types.String `tfsdk:"commit_message"`
+	CommitID      types.String `tfsdk:"commit_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *nqeQueryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nqe_query"
+}
+
+// Schema defines the schema for the resource.
+func (r *nqeQueryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a query in the Network Query Engine (NQE) library.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the query in the NQE library.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path of the query in the NQE library, such as /Org/Interfaces/Free Interfaces.",
+				Required:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "Source text of the query.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the query.",
+				Optional:    true,
+			},
+			"committed": schema.BoolAttribute{
+				Description: "Whether to commit the query source to the organization repository, publishing it to other users. " +
+					"When true, uncommitted changes made outside of Terraform are reported as drift. Defaults to false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"commit_message": schema.StringAttribute{
+				Description: "Message used when committing the query.",
+				Optional:    true,
+			},
+			"commit_id": schema.StringAttribute{
+				Description: "Identifier of the latest commit of the query, if it has been committed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *nqeQueryResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = req.ProviderData.(*providerData).client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *nqeQueryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan nqeQueryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new query
+	query, err := r.client.CreateNqeLibraryQuery(forwardnetworks.NqeLibraryQuery{
+		Path:        plan.Path.ValueString(),
+		SourceCode:  plan.Source.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating NQE Query",
+			"Could not create NQE query "+plan.Path.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	committed := plan.Committed
+	plan.refresh(query)
+
+	// The query is saved before it is committed, so it is tracked by
+	// Terraform even if the commit fails.
+	if committed.ValueBool() {
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		query, err = r.client.CommitNqeLibraryQuery(query.QueryID, forwardnetworks.NqeCommit{
+			Message: plan.CommitMessage.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Committing NQE Query",
+				"Could not commit NQE query "+plan.Path.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		plan.Committed = committed
+		plan.refresh(query)
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *nqeQueryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state nqeQueryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed query value from Forward Networks
+	query, err := r.client.GetNqeLibraryQuery(state.ID.ValueString())
+	if errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading NQE Query",
+			"Could not read NQE query ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite attributes with refreshed state
+	state.refresh(query)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *nqeQueryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan nqeQueryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update existing query
+	query, err := r.client.UpdateNqeLibraryQuery(plan.ID.ValueString(), forwardnetworks.NqeLibraryQuery{
+		Path:        plan.Path.ValueString(),
+		SourceCode:  plan.Source.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating NQE Query",
+			"Could not update NQE query ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Update resource state with the refreshed query
+	committed := plan.Committed
+	plan.refresh(query)
+
+	// The updated query is saved before it is committed, so the state
+	// matches the uncommitted query even if the commit fails.
+	if committed.ValueBool() && !query.Committed {
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		query, err = r.client.CommitNqeLibraryQuery(query.QueryID, forwardnetworks.NqeCommit{
+			Message: plan.CommitMessage.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Committing NQE Query",
+				"Could not commit NQE query ID "+plan.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		plan.Committed = committed
+		plan.refresh(query)
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *nqeQueryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state nqeQueryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete existing query
+	err := r.client.DeleteNqeLibraryQuery(state.ID.ValueString())
+	if err != nil && !errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error Deleting NQE Query",
+			"Could not delete NQE query ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports an existing query by its identifier or, when the import
+// ID starts with a slash, by its path in the NQE library.
+func (r *nqeQueryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if !strings.HasPrefix(req.ID, "/") {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	queries, err := r.client.GetNqeLibraryQueries()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing NQE Query",
+			"Could not list NQE library queries: "+err.Error(),
+		)
+		return
+	}
+
+	for _, query := range queries {
+		if query.Path == req.ID {
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), query.QueryID)...)
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Error Importing NQE Query",
+		"No NQE library query exists at path "+req.ID+".",
+	)
+}
+
+// refresh copies the API representation of a query into the model. The
+// committed attribute only tracks the API when it is not explicitly false,
+// since a query that is not managed as committed may still have been
+// committed outside of Terraform.
+func (m *nqeQueryResourceModel) refresh(query *forwardnetworks.NqeLibraryQuery) {
+	m.ID = types.StringValue(query.QueryID)
+	m.Path = types.StringValue(query.Path)
+	m.Source = types.StringValue(query.SourceCode)
+	if query.Description != "" {
+		m.Description = types.StringValue(query.Description)
+	} else {
+		m.Description = types.StringNull()
+	}
+	if m.Committed.IsNull() || m.Committed.ValueBool() {
+		m.Committed = types.BoolValue(query.Committed)
+	}
+	if query.CommitID != "" {
+		m.CommitID = types.StringValue(query.CommitID)
+	} else {
+		m.CommitID = types.StringNull()
+	}
+}
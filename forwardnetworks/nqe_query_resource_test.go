@@ -0,0 +1,171 @@
+package forwardnetworks
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNqeQueryResource(t *testing.T) {
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_nqe_query" "test" {
+  path        = "/Org/Interfaces/Free"
+  source      = "foreach d in network.devices select { name: d.name }"
+  description = "Free interfaces"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_nqe_query.test", "path", "/Org/Interfaces/Free"),
+					resource.TestCheckResourceAttr("forwardnetworks_nqe_query.test", "description", "Free interfaces"),
+					resource.TestCheckResourceAttr("forwardnetworks_nqe_query.test", "committed", "false"),
+					resource.TestCheckNoResourceAttr("forwardnetworks_nqe_query.test", "commit_id"),
+					resource.TestCheckResourceAttrSet("forwardnetworks_nqe_query.test", "id"),
+				),
+			},
+			// ImportState by ID testing
+			{
+				ResourceName:      "forwardnetworks_nqe_query.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// ImportState by path testing
+			{
+				ResourceName:      "forwardnetworks_nqe_query.test",
+				ImportState:       true,
+				ImportStateId:     "/Org/Interfaces/Free",
+				ImportStateVerify: true,
+			},
+			// Update and commit testing
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_nqe_query" "test" {
+  path           = "/Org/Interfaces/Free"
+  source         = "foreach d in network.devices select { name: d.name, platform: d.platform }"
+  committed      = true
+  commit_message = "Add platform"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("forwardnetworks_nqe_query.test", "description"),
+					resource.TestCheckResourceAttr("forwardnetworks_nqe_query.test", "committed", "true"),
+					resource.TestCheckResourceAttrSet("forwardnetworks_nqe_query.test", "commit_id"),
+				),
+			},
+			// Drift testing
+			{
+				PreConfig: func() {
+					srv.editNqeLibraryQuery("/Org/Interfaces/Free", "foreach d in network.devices select { name: d.name }")
+				},
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_nqe_query" "test" {
+  path           = "/Org/Interfaces/Free"
+  source         = "foreach d in network.devices select { name: d.name, platform: d.platform }"
+  committed      = true
+  commit_message = "Add platform"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_nqe_query.test", "committed", "true"),
+					testAccCheckNqeLibraryQuerySource(srv, "forwardnetworks_nqe_query.test",
+						"foreach d in network.devices select { name: d.name, platform: d.platform }"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccNqeQueryResource_commitFails(t *testing.T) {
+	const commitPattern = "/api/nqe/library/queries/*/commit"
+	srv := newFakeServer(t)
+	srv.failRoute(http.MethodPost, commitPattern)
+
+	config := func(source string) string {
+		return srv.providerConfig() + `
+resource "forwardnetworks_nqe_query" "test" {
+  path      = "/Org/Interfaces/Free"
+  source    = "` + source + `"
+  committed = true
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		// The query created before the failed commit is tracked in the
+		// state, so it is destroyed rather than left behind.
+		CheckDestroy: func(_ *terraform.State) error {
+			if n := srv.nqeLibraryQueryCount(); n != 0 {
+				return fmt.Errorf("expected no NQE queries, got %d", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      config("foreach d in network.devices select { name: d.name }"),
+				ExpectError: regexp.MustCompile(`Could not commit NQE query /Org/Interfaces/Free`),
+			},
+			// The tainted query is replaced instead of conflicting with the
+			// query left at its path.
+			{
+				PreConfig: func() { srv.restoreRoute(http.MethodPost, commitPattern) },
+				Config:    config("foreach d in network.devices select { name: d.name }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_nqe_query.test", "committed", "true"),
+					resource.TestCheckResourceAttrSet("forwardnetworks_nqe_query.test", "commit_id"),
+					func(_ *terraform.State) error {
+						if n := srv.nqeLibraryQueryCount(); n != 1 {
+							return fmt.Errorf("expected 1 NQE query, got %d", n)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig:   func() { srv.failRoute(http.MethodPost, commitPattern) },
+				Config:      config("foreach d in network.devices select { name: d.name, platform: d.platform }"),
+				ExpectError: regexp.MustCompile(`Could not commit NQE query ID`),
+			},
+			// The updated source was saved, so only the commit is retried.
+			{
+				PreConfig: func() { srv.restoreRoute(http.MethodPost, commitPattern) },
+				Config:    config("foreach d in network.devices select { name: d.name, platform: d.platform }"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_nqe_query.test", "committed", "true"),
+					testAccCheckNqeLibraryQuerySource(srv, "forwardnetworks_nqe_query.test",
+						"foreach d in network.devices select { name: d.name, platform: d.platform }"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckNqeLibraryQuerySource verifies the query stored by the fake
+// server has the expected source.
+func testAccCheckNqeLibraryQuerySource(srv *fakeServer, resourceName, source string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %s not found in state", resourceName)
+		}
+		query := srv.nqeLibraryQuery(rs.Primary.ID)
+		if query == nil {
+			return fmt.Errorf("NQE query %s does not exist", rs.Primary.ID)
+		}
+		if query.SourceCode != source {
+			return fmt.Errorf("expected NQE query source %q, got %q", source, query.SourceCode)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,314 @@
+package forwardnetworks
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// pathSearchIntents lists the path search intents accepted by the Forward
+// Networks API.
+var pathSearchIntents = []string{"PREFER_DELIVERED", "PREFER_VIOLATIONS", "VIOLATIONS_ONLY"}
+
+// ipProtocols maps IP protocol names to their numbers.
+var ipProtocols = map[string]int{
+	"icmp": 1,
+	"tcp":  6,
+	"udp":  17,
+}
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &pathSearchDataSource{}
+	_ datasource.DataSourceWithConfigure      = &pathSearchDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &pathSearchDataSource{}
+)
+
+// NewPathSearchDataSource is a helper function to simplify the provider implementation.
+func NewPathSearchDataSource() datasource.DataSource {
+	return &pathSearchDataSource{}
+}
+
+// pathSearchDataSource is the data source implementation.
+type pathSearchDataSource struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// pathSearchDataSourceModel maps the data source schema data.
+type pathSearchDataSourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	NetworkID       types.String `tfsdk:"network_id"`
+	SnapshotID      types.String `tfsdk:"snapshot_id"`
+	Source          types.String `tfsdk:"source"`
+	Destination     types.String `tfsdk:"destination"`
+	Protocol        types.String `tfsdk:"protocol"`
+	SourcePort      types.String `tfsdk:"source_port"`
+	DestinationPort types.String `tfsdk:"destination_port"`
+	Intent          types.String `tfsdk:"intent"`
+	MaxResults      types.Int64  `tfsdk:"max_results"`
+	Delivered       types.Bool   `tfsdk:"delivered"`
+	Paths           []pathModel  `tfsdk:"paths"`
+}
+
+// pathModel maps path data.
+type pathModel struct {
+	ForwardingOutcome types.String   `tfsdk:"forwarding_outcome"`
+	SecurityOutcome   types.String   `tfsdk:"security_outcome"`
+	Hops              []pathHopModel `tfsdk:"hops"`
+}
+
+// pathHopModel maps path hop data.
+type pathHopModel struct {
+	DeviceName       types.String `tfsdk:"device_name"`
+	DeviceType       types.String `tfsdk:"device_type"`
+	IngressInterface types.String `tfsdk:"ingress_interface"`
+	EgressInterface  types.String `tfsdk:"egress_interface"`
+}
+
+// Metadata returns the data source type name.
+func (d *pathSearchDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_path_search"
+}
+
+// Schema defines the schema for the data source.
+func (d *pathSearchDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Searches the paths traffic takes through a Forward Networks network.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The network ID to search. Defaults to the default_network_id of the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "The snapshot ID to search. May also be latest or latestProcessed. Defaults to the default_snapshot of the provider, or the latest processed snapshot of the network; once read, holds the snapshot that was searched.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "IP address, subnet or host name the traffic originates from.",
+				Optional:    true,
+			},
+			"destination": schema.StringAttribute{
+				Description: "IP address, subnet or host name the traffic is sent to.",
+				Required:    true,
+			},
+			"protocol": schema.StringAttribute{
+				Description: "IP protocol of the traffic, either tcp, udp, icmp or a protocol number.",
+				Optional:    true,
+			},
+			"source_port": schema.StringAttribute{
+				Description: "Source port or port range of the traffic.",
+				Optional:    true,
+			},
+			"destination_port": schema.StringAttribute{
+				Description: "Destination port or port range of the traffic.",
+				Optional:    true,
+			},
+			"intent": schema.StringAttribute{
+				Description: "Which paths to prefer: one of " + strings.Join(pathSearchIntents, ", ") + ". Defaults to PREFER_DELIVERED.",
+				Optional:    true,
+			},
+			"max_results": schema.Int64Attribute{
+				Description: "Maximum number of paths to return.",
+				Optional:    true,
+			},
+			"delivered": schema.BoolAttribute{
+				Description: "Whether any of the returned paths delivers the traffic to the destination.",
+				Computed:    true,
+			},
+			"paths": schema.ListNestedAttribute{
+				Description: "The paths found for the traffic.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"forwarding_outcome": schema.StringAttribute{
+							Description: "Forwarding outcome of the path, such as DELIVERED or DROPPED.",
+							Computed:    true,
+						},
+						"security_outcome": schema.StringAttribute{
+							Description: "Security outcome of the path, such as PERMITTED or DENIED.",
+							Computed:    true,
+						},
+						"hops": schema.ListNestedAttribute{
+							Description: "The devices the path traverses, in order.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"device_name": schema.StringAttribute{
+										Description: "Name of the device.",
+										Computed:    true,
+									},
+									"device_type": schema.StringAttribute{
+										Description: "Type of the device.",
+										Computed:    true,
+									},
+									"ingress_interface": schema.StringAttribute{
+										Description: "Interface the traffic enters the device on.",
+										Computed:    true,
+									},
+									"egress_interface": schema.StringAttribute{
+										Description: "Interface the traffic leaves the device on.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures the protocol and intent have supported values.
+func (d *pathSearchDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config pathSearchDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Protocol.IsNull() && !config.Protocol.IsUnknown() {
+		if _, err := ipProtocolNumber(config.Protocol.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("protocol"),
+				"Invalid Protocol",
+				"The protocol must be tcp, udp, icmp or a protocol number between 0 and 255.",
+			)
+		}
+	}
+
+	if !config.Intent.IsNull() && !config.Intent.IsUnknown() {
+		valid := false
+		for _, intent := range pathSearchIntents {
+			valid = valid || config.Intent.ValueString() == intent
+		}
+		if !valid {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("intent"),
+				"Invalid Path Search Intent",
+				"The intent must be one of "+strings.Join(pathSearchIntents, ", ")+".",
+			)
+		}
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *pathSearchDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.defaults = data.defaults
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *pathSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state pathSearchDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.NetworkID = d.defaults.resolveNetworkID(state.NetworkID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	snapshotID := d.defaults.resolveSnapshotID(d.client, state.NetworkID.ValueString(), state.SnapshotID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := forwardnetworks.PathSearchQuery{
+		Source:          state.Source.ValueString(),
+		Destination:     state.Destination.ValueString(),
+		SourcePort:      state.SourcePort.ValueString(),
+		DestinationPort: state.DestinationPort.ValueString(),
+		Intent:          state.Intent.ValueString(),
+		MaxResults:      int(state.MaxResults.ValueInt64()),
+	}
+	if !state.Protocol.IsNull() {
+		protocol, err := ipProtocolNumber(state.Protocol.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("protocol"),
+				"Invalid Protocol",
+				"The protocol must be tcp, udp, icmp or a protocol number between 0 and 255.",
+			)
+			return
+		}
+		query.IPProtocol = &protocol
+	}
+
+	result, err := d.client.SearchPaths(state.NetworkID.ValueString(), snapshotID, query)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Search Forward Networks Paths",
+			"Could not search paths on network ID "+state.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Delivered = types.BoolValue(false)
+	state.Paths = []pathModel{}
+	for _, p := range result.Paths {
+		pathState := pathModel{
+			ForwardingOutcome: types.StringValue(p.ForwardingOutcome),
+			SecurityOutcome:   types.StringValue(p.SecurityOutcome),
+			Hops:              []pathHopModel{},
+		}
+		for _, hop := range p.Hops {
+			pathState.Hops = append(pathState.Hops, pathHopModel{
+				DeviceName:       types.StringValue(hop.DeviceName),
+				DeviceType:       stringValueOrNull(hop.DeviceType),
+				IngressInterface: stringValueOrNull(hop.IngressInterface),
+				EgressInterface:  stringValueOrNull(hop.EgressInterface),
+			})
+		}
+		state.Paths = append(state.Paths, pathState)
+
+		if p.ForwardingOutcome == "DELIVERED" {
+			state.Delivered = types.BoolValue(true)
+		}
+	}
+
+	state.SnapshotID = types.StringValue(result.SnapshotID)
+	state.ID = types.StringValue(result.SnapshotID)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// ipProtocolNumber converts an IP protocol name or number to its number.
+func ipProtocolNumber(protocol string) (int, error) {
+	if number, ok := ipProtocols[strings.ToLower(protocol)]; ok {
+		return number, nil
+	}
+	number, err := strconv.ParseUint(protocol, 10, 8)
+	if err != nil {
+		return 0, err
+	}
+	return int(number), nil
+}
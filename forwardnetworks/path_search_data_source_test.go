@@ -0,0 +1,123 @@
+package forwardnetworks
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPathSearchDataSource(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("paths")
+	snapshotID := srv.addSnapshot(networkID, snapshotStateProcessed, time.Now())
+	srv.setPaths("10.1.0.10", []forwardnetworks.Path{
+		{
+			ForwardingOutcome: "DELIVERED",
+			SecurityOutcome:   "PERMITTED",
+			Hops: []forwardnetworks.PathHop{
+				{DeviceName: "edge-1", DeviceType: "ROUTER", EgressInterface: "ge-0/0/1"},
+				{DeviceName: "core-1", DeviceType: "SWITCH", IngressInterface: "Ethernet1", EgressInterface: "Ethernet2"},
+			},
+		},
+		{
+			ForwardingOutcome: "DROPPED",
+			SecurityOutcome:   "DENIED",
+			Hops: []forwardnetworks.PathHop{
+				{DeviceName: "fw-1", DeviceType: "FIREWALL", IngressInterface: "eth1"},
+			},
+		},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_path_search" "test" {
+  network_id       = "` + networkID + `"
+  source           = "10.0.0.5"
+  destination      = "10.1.0.10"
+  protocol         = "tcp"
+  destination_port = "443"
+  intent           = "PREFER_VIOLATIONS"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_path_search.test", "snapshot_id", snapshotID),
+					resource.TestCheckResourceAttr("data.forwardnetworks_path_search.test", "delivered", "true"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_path_search.test", "paths.#", "2"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_path_search.test", "paths.0.forwarding_outcome", "DELIVERED"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_path_search.test", "paths.0.hops.#", "2"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_path_search.test", "paths.0.hops.1.device_name", "core-1"),
+					resource.TestCheckNoResourceAttr("data.forwardnetworks_path_search.test", "paths.0.hops.0.ingress_interface"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_path_search.test", "paths.1.security_outcome", "DENIED"),
+					testAccCheckPathSearchProtocol(srv, 6),
+				),
+			},
+			// Max results testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_path_search" "test" {
+  network_id  = "` + networkID + `"
+  destination = "10.1.0.10"
+  max_results = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_path_search.test", "paths.#", "1"),
+				),
+			},
+			// Unreachable destination testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_path_search" "test" {
+  network_id  = "` + networkID + `"
+  destination = "192.0.2.1"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_path_search.test", "paths.#", "0"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_path_search.test", "delivered", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPathSearchDataSource_invalidIntent(t *testing.T) {
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_path_search" "test" {
+  network_id  = "1"
+  destination = "10.1.0.10"
+  intent      = "DELIVERED"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Path Search Intent"),
+			},
+		},
+	})
+}
+
+// testAccCheckPathSearchProtocol verifies the last path search received by the
+// fake server used the expected IP protocol number.
+func testAccCheckPathSearchProtocol(srv *fakeServer, protocol int) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		got := srv.lastPathSearch().IPProtocol
+		if got == nil || *got != protocol {
+			return fmt.Errorf("expected IP protocol %d, got %v", protocol, got)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,125 @@
+package forwardnetworks
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultProfileName is the profile used when none is configured.
+const defaultProfileName = "default"
+
+// profile holds the settings of a named profile from the provider config
+// file, keyed by provider attribute name.
+//
+// The config file holds one section per profile, for example:
+//
+//	[default]
+//	host      = https://fwd.app
+//	api_token = ...
+//
+//	[onprem]
+//	host         = https://fwd.example.com
+//	username     = admin
+//	password     = ...
+//	ca_cert_file = /etc/ssl/certs/example-ca.pem
+type profile map[string]string
+
+// value resolves a provider setting with the precedence configuration,
+// environment variable env, then the profile. An empty env skips the
+// environment.
+func (p profile) value(config types.String, env, key string) string {
+	if !config.IsNull() {
+		return config.ValueString()
+	}
+	if env != "" {
+		if value := os.Getenv(env); value != "" {
+			return value
+		}
+	}
+	return p[key]
+}
+
+// defaultConfigFile returns the default path of the provider config file.
+func defaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".forwardnetworks", "config")
+}
+
+// loadProfile reads the named profile from the config file. When required is
+// false, a missing config file or profile yields an empty profile instead
+// of an error.
+func loadProfile(configFile, name string, required bool) (profile, error) {
+	file, err := os.Open(configFile)
+	if errors.Is(err, os.ErrNotExist) && !required {
+		return profile{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	profiles, err := parseProfiles(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", configFile, err)
+	}
+
+	p, ok := profiles[name]
+	if !ok {
+		if !required {
+			return profile{}, nil
+		}
+		return nil, fmt.Errorf("profile %q not found in %s", name, configFile)
+	}
+	return p, nil
+}
+
+// parseProfiles parses the profiles of a config file. Blank lines and lines
+// starting with # or ; are ignored.
+func parseProfiles(r io.Reader) (map[string]profile, error) {
+	profiles := map[string]profile{}
+	var current profile
+
+	scanner := bufio.NewScanner(r)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNumber)
+			}
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = profile{}
+			}
+			current = profiles[name]
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected a [profile] header or a key = value setting", lineNumber)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: setting outside of a [profile] section", lineNumber)
+		}
+		current[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
@@ -0,0 +1,69 @@
+package forwardnetworks
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseProfiles(t *testing.T) {
+	profiles, err := parseProfiles(strings.NewReader(`
+# Forward Networks SaaS
+[default]
+host      = https://fwd.app
+api_token = secret=token
+
+; On-prem appliance
+[ onprem ]
+host     = https://fwd.example.com
+username = admin
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]profile{
+		"default": {"host": "https://fwd.app", "api_token": "secret=token"},
+		"onprem":  {"host": "https://fwd.example.com", "username": "admin"},
+	}
+	if !reflect.DeepEqual(profiles, want) {
+		t.Errorf("expected profiles %v, got %v", want, profiles)
+	}
+}
+
+func TestParseProfiles_invalid(t *testing.T) {
+	for name, content := range map[string]string{
+		"setting outside profile": "host = https://fwd.app\n",
+		"empty profile name":      "[]\nhost = https://fwd.app\n",
+		"missing separator":       "[default]\nhost\n",
+	} {
+		if _, err := parseProfiles(strings.NewReader(content)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestLoadProfile(t *testing.T) {
+	configFile := writeTestConfigFile(t, "[default]\nhost = https://fwd.app\n")
+	missingFile := filepath.Join(t.TempDir(), "missing")
+
+	p, err := loadProfile(configFile, "default", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p["host"] != "https://fwd.app" {
+		t.Errorf("unexpected profile %v", p)
+	}
+
+	if _, err := loadProfile(configFile, "onprem", true); err == nil {
+		t.Error("expected an error for a missing required profile")
+	}
+	if _, err := loadProfile(missingFile, "default", true); err == nil {
+		t.Error("expected an error for a missing config file")
+	}
+
+	if p, err := loadProfile(missingFile, "default", false); err != nil || len(p) != 0 {
+		t.Errorf("expected an empty optional profile, got %v, %v", p, err)
+	}
+}
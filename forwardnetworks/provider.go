@@ -1,218 +1,674 @@
-package forwardnetworks
-
-import (
-	"context"
-	"os"
-
-	"github.com/forwardnetworks/forwardnetworks-client-go"
-	"github.com/hashicorp/terraform-plugin-framework/datasource"
-    "github.com/hashicorp/terraform-plugin-framework/path"
-    "github.com/hashicorp/terraform-plugin-framework/provider"
-    "github.com/hashicorp/terraform-plugin-framework/provider/schema"
-    "github.com/hashicorp/terraform-plugin-framework/resource"
-    "github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
-)
-
-// Ensure the implementation satisfies the expected interfaces
-var (
-	_ provider.Provider = &forwardnetworksProvider{}
-)
-
-// New is a helper function to simplify provider server and testing implementation.
-func New() provider.Provider {
-	return &forwardnetworksProvider{}
-}
-
-// forwardnetworksProvider is the provider implementation.
-type forwardnetworksProvider struct{}
-
-// Metadata returns the provider type name.
-func (p *forwardnetworksProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
-	resp.TypeName = "forwardnetworks"
-}
-
-// Schema defines the provider-level schema for configuration data.
-func (p *forwardnetworksProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
-    resp.Schema = schema.Schema{
-		Description: "Interact with Forward Networks API.",
-        Attributes: map[string]schema.Attribute{
-            "host": schema.StringAttribute{
-				Description: "URI for Forward Networks API. May also be provided via FORWARDNETWORKS_HOST environment variable. Defaults to https://fwd.app",
-                Optional: true,
-            },
-            "username": schema.StringAttribute{
-				Description: "Username for Forward Networks API. May also be provided via FORWARDNETWORKS_USERNAME environment variable.",
-                Optional: true,
-            },
-            "password": schema.StringAttribute{
-				Description: "Password for Forward Networks API. May also be provided via FORWARDNETWORKS_PASSWORD environment variable.",
-                Optional:  true,
-                Sensitive: true,
-            },
-			"insecure" : schema.BoolAttribute{
-				Description: "Allow for connections to Forward Networks on prem instances without SSL verification.  Defaults to FALSE.",
-				Optional:	true,
-			},
-        },
-    }
-}
-
-// forwardnetworksProviderModel maps provider schema data to a Go type.
-type forwardnetworksProviderModel struct {
-    Host     types.String `tfsdk:"host"`
-    Username types.String `tfsdk:"username"`
-    Password types.String `tfsdk:"password"`
-	Insecure types.Bool   `tfsdk:"insecure"`
-}
-
-
-
-func (p *forwardnetworksProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
-    // Retrieve provider data from configuration
-	tflog.Info(ctx, "Configuring Forward Networks client")
-    var config forwardnetworksProviderModel
-    diags := req.Config.Get(ctx, &config)
-    resp.Diagnostics.Append(diags...)
-    if resp.Diagnostics.HasError() {
-        return
-    }
-
-    // If practitioner provided a configuration value for any of the
-    // attributes, it must be a known value.
-
-    if config.Host.IsUnknown() {
-        resp.Diagnostics.AddAttributeError(
-            path.Root("host"),
-            "Unknown Forward Networks API Host",
-            "The provider cannot create the Forward Networks API client as there is an unknown configuration value for the Forward Networks API host. "+
-                "Either target apply the source of the value first, set the value statically in the configuration, or use the FORWARDNETWORKS_HOST environment variable.",
-        )
-    }
-
-    if config.Username.IsUnknown() {
-        resp.Diagnostics.AddAttributeError(
-            path.Root("username"),
-            "Unknown Forward Networks API Username",
-            "The provider cannot create the Forward Networks API client as there is an unknown configuration value for the Forward Networks API username. "+
-                "Either target apply the source of the value first, set the value statically in the configuration, or use the FORWARDNETWORKS_USERNAME environment variable.",
-        )
-    }
-
-    if config.Password.IsUnknown() {
-        resp.Diagnostics.AddAttributeError(
-            path.Root("password"),
-            "Unknown Forward Networks API Password",
-            "The provider cannot create the Forward Networks API client as there is an unknown configuration value for the Forward Networks API password. "+
-                "Either target apply the source of the value first, set the value statically in the configuration, or use the FORWARDNETWORKS_PASSWORD environment variable.",
-        )
-    }
-
-    if resp.Diagnostics.HasError() {
-        return
-    }
-
-    // Default values to environment variables, but override
-    // with Terraform configuration value if set.
-
-    host := os.Getenv("FORWARDNETWORKS_HOST")
-    username := os.Getenv("FORWARDNETWORKS_USERNAME")
-    password := os.Getenv("FORWARDNETWORKS_PASSWORD")
-	insecure := false
-
-    if !config.Host.IsNull() {
-        host = config.Host.ValueString()
-	} else if host == "" {
-        host = "https://fwd.app" // Default host
-    }
-
-    if !config.Username.IsNull() {
-        username = config.Username.ValueString()
-    }
-
-    if !config.Password.IsNull() {
-        password = config.Password.ValueString()
-    }
-	
-	if !config.Insecure.IsNull() {
-        insecure = config.Insecure.ValueBool()
-    }
-
-    // If any of the expected configurations are missing, return
-    // errors with provider-specific guidance.
-
-    if host == "" {
-        resp.Diagnostics.AddAttributeError(
-            path.Root("host"),
-            "Missing Forward Networks API Host",
-            "The provider cannot create the Forward Networks API client as there is a missing or empty value for the Forward Networks API host. "+
-                "Set the host value in the configuration or use the FWDNET_HOST environment variable. "+
-                "If either is already set, ensure the value is not empty.",
-        )
-    }
-
-    if username == "" {
-        resp.Diagnostics.AddAttributeError(
-            path.Root("username"),
-            "Missing Forward Networks API Username",
-            "The provider cannot create the Forward Networks API client as there is a missing or empty value for the Forward Networks API username. "+
-                "Set the username value in the configuration or use the FWDNET_USERNAME environment variable. "+
-                "If either is already set, ensure the value is not empty.",
-        )
-    }
-
-    if password == "" {
-        resp.Diagnostics.AddAttributeError(
-            path.Root("password"),
-            "Missing Forward Networks API Password",
-            "The provider cannot create the Forward Networks API client as there is a missing or empty value for the Forward Networks API password. "+
-                "Set the password value in the configuration or use the FWDNET_PASSWORD environment variable. "+
-                "If either is already set, ensure the value is not empty.",
-        )
-    }
-
-    if resp.Diagnostics.HasError() {
-        return
-    }
-	
-	ctx = tflog.SetField(ctx, "forwardnetworks_host", host)
-    ctx = tflog.SetField(ctx, "forwardnetworks_username", username)
-    ctx = tflog.SetField(ctx, "forwardnetworks_password", password)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "forwardnetworks_password")
-
-    tflog.Debug(ctx, "Creating Forward Networks client")
-	
-    // Create a new Forward Networks client using the configuration values
-    client, err := forwardnetworks.NewClient(&host, &username, &password, insecure)
-    if err != nil {
-        resp.Diagnostics.AddError(
-            "Unable to Create Forward Networks API Client",
-            "An unexpected error occurred when creating the Forward Networks API client. "+
-                "If the error is not clear, please contact the provider developers.\n\n"+
-                "Forward Networks Client Error: "+err.Error(),
-        )
-        return
-    }
-
-    // Make the Forward Networks client available during DataSource and Resource
-    // type Configure methods.
-    resp.DataSourceData = client
-    resp.ResourceData = client
-	
-	tflog.Info(ctx, "Configured Forward Networks client", map[string]any{"success": true})
-}
-
-
-// DataSources defines the data sources implemented in the provider.
-func (p *forwardnetworksProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource {
-		NewVersionDataSource,
-        NewExternalIdDataSource,
-	}
-}
-
-// Resources defines the resources implemented in the provider.
-func (p *forwardnetworksProvider) Resources(_ context.Context) []func() resource.Resource {
-    return []func() resource.Resource{
-    }
-}
+package forwardnetworks
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// Ensure the implementation satisfies the expected interfaces
+var (
+	_ provider.Provider = &forwardnetworksProvider{}
+)
+
+// New is a helper function to simplify provider server and testing implementation.
+func New() provider.Provider {
+	return &forwardnetworksProvider{}
+}
+
+// forwardnetworksProvider is the provider implementation.
+type forwardnetworksProvider struct{}
+
+// Metadata returns the provider type name.
+func (p *forwardnetworksProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "forwardnetworks"
+}
+
+// Schema defines the provider-level schema for configuration data.
+func (p *forwardnetworksProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Interact with Forward Networks API.",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				Description: "URI for Forward Networks API. May also be provided via FORWARDNETWORKS_HOST environment variable. Defaults to https://fwd.app",
+				Optional:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username for Forward Networks API. May also be provided via FORWARDNETWORKS_USERNAME environment variable.",
+				Optional:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password for Forward Networks API. May also be provided via FORWARDNETWORKS_PASSWORD environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"api_token": schema.StringAttribute{
+				Description: "API token for Forward Networks API, used instead of username and password. May also be provided via FORWARDNETWORKS_API_TOKEN environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"profile": schema.StringAttribute{
+				Description: "Name of the profile in the config file to read settings from. Settings in the configuration and environment variables take precedence over the profile. " +
+					"May also be provided via FORWARDNETWORKS_PROFILE environment variable. Defaults to the default profile, if present.",
+				Optional: true,
+			},
+			"config_file": schema.StringAttribute{
+				Description: "Path to the config file holding the profiles. May also be provided via FORWARDNETWORKS_CONFIG_FILE environment variable. Defaults to ~/.forwardnetworks/config.",
+				Optional:    true,
+			},
+			"credential_process": schema.StringAttribute{
+				Description: "Command run to obtain credentials for Forward Networks API, used instead of username and password or api_token. " +
+					"The command must print a JSON object with either api_token or username and password, and optionally an RFC 3339 expiration after which it is run again. " +
+					"May also be provided via FORWARDNETWORKS_CREDENTIAL_PROCESS environment variable.",
+				Optional: true,
+			},
+			"insecure": schema.BoolAttribute{
+				Description: "Allow for connections to Forward Networks on prem instances without SSL verification.  Defaults to FALSE.",
+				Optional:    true,
+			},
+			"default_network_id": schema.StringAttribute{
+				Description: "Network ID used by data sources and resources that omit their network_id. May also be provided via FORWARDNETWORKS_DEFAULT_NETWORK_ID environment variable.",
+				Optional:    true,
+			},
+			"default_snapshot": schema.StringAttribute{
+				Description: "Snapshot used by data sources that omit their snapshot_id: latest, latestProcessed or a snapshot ID. " +
+					"May also be provided via FORWARDNETWORKS_DEFAULT_SNAPSHOT environment variable. Defaults to latestProcessed.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Description: "Path to a PEM-encoded CA certificate bundle trusted in addition to the system certificates. May also be provided via FORWARDNETWORKS_CA_CERT_FILE environment variable.",
+				Optional:    true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Description: "PEM-encoded CA certificate bundle trusted in addition to the system certificates. May also be provided via FORWARDNETWORKS_CA_CERT_PEM environment variable.",
+				Optional:    true,
+			},
+			"client_cert": schema.StringAttribute{
+				Description: "PEM-encoded client certificate, or the path to one, presented for mutual TLS. Requires client_key. May also be provided via FORWARDNETWORKS_CLIENT_CERT environment variable.",
+				Optional:    true,
+			},
+			"client_key": schema.StringAttribute{
+				Description: "PEM-encoded private key of the client certificate, or the path to one. Requires client_cert. May also be provided via FORWARDNETWORKS_CLIENT_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"tls_server_name": schema.StringAttribute{
+				Description: "Server name used to verify the certificate of the Forward Networks API, when it differs from the host name. May also be provided via FORWARDNETWORKS_TLS_SERVER_NAME environment variable.",
+				Optional:    true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of the HTTP, HTTPS or SOCKS5 proxy used to connect to Forward Networks API, such as http://proxy.example.com:3128. Defaults to the proxy from the HTTPS_PROXY and HTTP_PROXY environment variables.",
+				Optional:    true,
+			},
+			"no_proxy": schema.StringAttribute{
+				Description: "Comma-separated list of hosts, domains and CIDR ranges connected to without the proxy. Defaults to the NO_PROXY environment variable.",
+				Optional:    true,
+			},
+			"proxy_username": schema.StringAttribute{
+				Description: "Username to authenticate with the proxy. May also be provided via FORWARDNETWORKS_PROXY_USERNAME environment variable.",
+				Optional:    true,
+			},
+			"proxy_password": schema.StringAttribute{
+				Description: "Password to authenticate with the proxy. May also be provided via FORWARDNETWORKS_PROXY_PASSWORD environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"debug_http": schema.BoolAttribute{
+				Description: "Log every request to and response from Forward Networks API, with credentials, tokens and device secrets redacted. " +
+					"Requests are logged at DEBUG level under the http subsystem, whose level can be set with the TF_LOG_PROVIDER_FORWARDNETWORKS_HTTP environment variable; " +
+					"setting that variable also enables the logging. Defaults to false.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of times a request failing with a 429 or 503 response is retried. " +
+					"Requests that are safe to repeat, such as reads and deletes, are also retried on connection errors and 502 or 504 responses. Defaults to 3.",
+				Optional: true,
+			},
+			"retry_min_wait": schema.StringAttribute{
+				Description: "Time to wait before the first retry, doubled for each further retry, as a duration such as \"500ms\" or \"2s\". Defaults to 1s.",
+				Optional:    true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Description: "Maximum time to wait between retries, including waits requested by a Retry-After header, as a duration such as \"1m\". Defaults to 30s.",
+				Optional:    true,
+			},
+			"request_timeout": schema.StringAttribute{
+				Description: "Timeout for each attempt of a request to the Forward Networks API, as a duration such as \"2m\". Defaults to the client timeout.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// forwardnetworksProviderModel maps provider schema data to a Go type.
+type forwardnetworksProviderModel struct {
+	Host     types.String `tfsdk:"host"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	APIToken types.String `tfsdk:"api_token"`
+	Insecure types.Bool   `tfsdk:"insecure"`
+
+	CredentialProcess types.String `tfsdk:"credential_process"`
+
+	Profile    types.String `tfsdk:"profile"`
+	ConfigFile types.String `tfsdk:"config_file"`
+
+	DefaultNetworkID types.String `tfsdk:"default_network_id"`
+	DefaultSnapshot  types.String `tfsdk:"default_snapshot"`
+
+	CACertFile    types.String `tfsdk:"ca_cert_file"`
+	CACertPEM     types.String `tfsdk:"ca_cert_pem"`
+	ClientCert    types.String `tfsdk:"client_cert"`
+	ClientKey     types.String `tfsdk:"client_key"`
+	TLSServerName types.String `tfsdk:"tls_server_name"`
+
+	ProxyURL      types.String `tfsdk:"proxy_url"`
+	NoProxy       types.String `tfsdk:"no_proxy"`
+	ProxyUsername types.String `tfsdk:"proxy_username"`
+	ProxyPassword types.String `tfsdk:"proxy_password"`
+
+	DebugHTTP types.Bool `tfsdk:"debug_http"`
+
+	MaxRetries     types.Int64  `tfsdk:"max_retries"`
+	RetryMinWait   types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait   types.String `tfsdk:"retry_max_wait"`
+	RequestTimeout types.String `tfsdk:"request_timeout"`
+}
+
+func (p *forwardnetworksProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	// Retrieve provider data from configuration
+	tflog.Info(ctx, "Configuring Forward Networks client")
+	var config forwardnetworksProviderModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// If practitioner provided a configuration value for any of the
+	// attributes, it must be a known value.
+
+	if config.Host.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Unknown Forward Networks API Host",
+			"The provider cannot create the Forward Networks API client as there is an unknown configuration value for the Forward Networks API host. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the FORWARDNETWORKS_HOST environment variable.",
+		)
+	}
+
+	if config.Username.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Unknown Forward Networks API Username",
+			"The provider cannot create the Forward Networks API client as there is an unknown configuration value for the Forward Networks API username. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the FORWARDNETWORKS_USERNAME environment variable.",
+		)
+	}
+
+	if config.Password.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Unknown Forward Networks API Password",
+			"The provider cannot create the Forward Networks API client as there is an unknown configuration value for the Forward Networks API password. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the FORWARDNETWORKS_PASSWORD environment variable.",
+		)
+	}
+
+	if config.APIToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token"),
+			"Unknown Forward Networks API Token",
+			"The provider cannot create the Forward Networks API client as there is an unknown configuration value for the Forward Networks API token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the FORWARDNETWORKS_API_TOKEN environment variable.",
+		)
+	}
+
+	for _, attr := range []struct {
+		name  string
+		value types.String
+		env   string
+	}{
+		{"profile", config.Profile, "FORWARDNETWORKS_PROFILE"},
+		{"config_file", config.ConfigFile, "FORWARDNETWORKS_CONFIG_FILE"},
+		{"credential_process", config.CredentialProcess, "FORWARDNETWORKS_CREDENTIAL_PROCESS"},
+		{"default_network_id", config.DefaultNetworkID, "FORWARDNETWORKS_DEFAULT_NETWORK_ID"},
+		{"default_snapshot", config.DefaultSnapshot, "FORWARDNETWORKS_DEFAULT_SNAPSHOT"},
+		{"ca_cert_file", config.CACertFile, "FORWARDNETWORKS_CA_CERT_FILE"},
+		{"ca_cert_pem", config.CACertPEM, "FORWARDNETWORKS_CA_CERT_PEM"},
+		{"client_cert", config.ClientCert, "FORWARDNETWORKS_CLIENT_CERT"},
+		{"client_key", config.ClientKey, "FORWARDNETWORKS_CLIENT_KEY"},
+		{"tls_server_name", config.TLSServerName, "FORWARDNETWORKS_TLS_SERVER_NAME"},
+		{"proxy_url", config.ProxyURL, "HTTPS_PROXY"},
+		{"no_proxy", config.NoProxy, "NO_PROXY"},
+		{"proxy_username", config.ProxyUsername, "FORWARDNETWORKS_PROXY_USERNAME"},
+		{"proxy_password", config.ProxyPassword, "FORWARDNETWORKS_PROXY_PASSWORD"},
+	} {
+		if attr.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.name),
+				"Unknown Forward Networks Connection Configuration",
+				"The provider cannot create the Forward Networks API client as there is an unknown configuration value for "+attr.name+". "+
+					"Either target apply the source of the value first, set the value statically in the configuration, or use the "+
+					attr.env+" environment variable.",
+			)
+		}
+	}
+
+	// These settings have no environment variable to fall back to.
+	for _, attr := range []struct {
+		name    string
+		unknown bool
+	}{
+		{"debug_http", config.DebugHTTP.IsUnknown()},
+		{"max_retries", config.MaxRetries.IsUnknown()},
+		{"retry_min_wait", config.RetryMinWait.IsUnknown()},
+		{"retry_max_wait", config.RetryMaxWait.IsUnknown()},
+		{"request_timeout", config.RequestTimeout.IsUnknown()},
+	} {
+		if attr.unknown {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.name),
+				"Unknown Forward Networks Connection Configuration",
+				"The provider cannot create the Forward Networks API client as there is an unknown configuration value for "+attr.name+". "+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Load the profile from the config file. Settings from the profile are
+	// used when neither the configuration nor the environment sets them.
+	profileName := config.Profile.ValueString()
+	if config.Profile.IsNull() {
+		profileName = os.Getenv("FORWARDNETWORKS_PROFILE")
+	}
+	configFile := config.ConfigFile.ValueString()
+	if config.ConfigFile.IsNull() {
+		configFile = os.Getenv("FORWARDNETWORKS_CONFIG_FILE")
+	}
+	configFileSet := configFile != ""
+	if !configFileSet {
+		configFile = defaultConfigFile()
+	}
+
+	var settings profile
+	if profileName == "" {
+		// The default profile is optional, unless the config file
+		// was set explicitly.
+		var err error
+		settings, err = loadProfile(configFile, defaultProfileName, false)
+		if err == nil && configFileSet {
+			_, err = os.Stat(configFile)
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config_file"),
+				"Unable to Load Forward Networks Config File",
+				"The provider cannot read the Forward Networks config file: "+err.Error(),
+			)
+		}
+	} else {
+		var err error
+		settings, err = loadProfile(configFile, profileName, true)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Unable to Load Forward Networks Profile",
+				"The provider cannot load the Forward Networks profile "+profileName+": "+err.Error(),
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve values from the Terraform configuration, then environment
+	// variables, then the profile.
+
+	host := settings.value(config.Host, "FORWARDNETWORKS_HOST", "host")
+	username := settings.value(config.Username, "FORWARDNETWORKS_USERNAME", "username")
+	password := settings.value(config.Password, "FORWARDNETWORKS_PASSWORD", "password")
+	apiToken := settings.value(config.APIToken, "FORWARDNETWORKS_API_TOKEN", "api_token")
+	credentialProcessCommand := settings.value(config.CredentialProcess, "FORWARDNETWORKS_CREDENTIAL_PROCESS", "credential_process")
+	insecure := false
+
+	if config.Host.IsNull() && host == "" {
+		host = "https://fwd.app" // Default host
+	}
+
+	// Credentials are taken from the first source that sets any of them, so
+	// only report a conflict when both kinds of credentials come from the
+	// same source.
+	userConfigured := !config.Username.IsNull() || !config.Password.IsNull()
+	tokenConfigured := !config.APIToken.IsNull()
+	processConfigured := !config.CredentialProcess.IsNull()
+	userFromEnv := os.Getenv("FORWARDNETWORKS_USERNAME") != "" || os.Getenv("FORWARDNETWORKS_PASSWORD") != ""
+	tokenFromEnv := os.Getenv("FORWARDNETWORKS_API_TOKEN") != ""
+	processFromEnv := os.Getenv("FORWARDNETWORKS_CREDENTIAL_PROCESS") != ""
+	switch {
+	case countTrue(tokenConfigured, userConfigured, processConfigured) > 1:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_token"),
+			"Conflicting Forward Networks API Credentials",
+			"The provider cannot create the Forward Networks API client as more than one kind of credentials is configured. "+
+				"Set only one of api_token, username and password, or credential_process.",
+		)
+	case tokenConfigured:
+		username, password, credentialProcessCommand = "", "", ""
+	case userConfigured:
+		apiToken, credentialProcessCommand = "", ""
+	case processConfigured:
+		username, password, apiToken = "", "", ""
+	case countTrue(tokenFromEnv, userFromEnv, processFromEnv) > 1:
+		resp.Diagnostics.AddError(
+			"Conflicting Forward Networks API Credentials",
+			"The provider cannot create the Forward Networks API client as more than one of the FORWARDNETWORKS_API_TOKEN, "+
+				"FORWARDNETWORKS_USERNAME or FORWARDNETWORKS_PASSWORD, and FORWARDNETWORKS_CREDENTIAL_PROCESS environment variables are set. "+
+				"Unset all but one of them, or set the credentials to use in the provider configuration.",
+		)
+	case tokenFromEnv:
+		username, password, credentialProcessCommand = "", "", ""
+	case userFromEnv:
+		apiToken, credentialProcessCommand = "", ""
+	case processFromEnv:
+		username, password, apiToken = "", "", ""
+	case countTrue(apiToken != "", username != "" || password != "", credentialProcessCommand != "") > 1:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Conflicting Forward Networks API Credentials",
+			"The provider cannot create the Forward Networks API client as the Forward Networks profile sets more than one kind of credentials. "+
+				"Keep only one of api_token, username and password, or credential_process in the profile in "+configFile+".",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Insecure.IsNull() {
+		insecure = config.Insecure.ValueBool()
+	} else if value, ok := settings["insecure"]; ok {
+		var err error
+		insecure, err = strconv.ParseBool(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Invalid Forward Networks Profile",
+				"The insecure setting of the Forward Networks profile must be true or false, got: "+value,
+			)
+		}
+	}
+
+	tlsConfig := tlsSettings{
+		Insecure:      insecure,
+		CACertFile:    settings.value(config.CACertFile, "FORWARDNETWORKS_CA_CERT_FILE", "ca_cert_file"),
+		CACertPEM:     settings.value(config.CACertPEM, "FORWARDNETWORKS_CA_CERT_PEM", "ca_cert_pem"),
+		ClientCert:    settings.value(config.ClientCert, "FORWARDNETWORKS_CLIENT_CERT", "client_cert"),
+		ClientKey:     settings.value(config.ClientKey, "FORWARDNETWORKS_CLIENT_KEY", "client_key"),
+		TLSServerName: settings.value(config.TLSServerName, "FORWARDNETWORKS_TLS_SERVER_NAME", "tls_server_name"),
+	}.tlsConfig(&resp.Diagnostics)
+
+	// The standard proxy environment variables take precedence over the
+	// proxy settings of the profile.
+	proxyEnv := httpproxy.FromEnvironment()
+	proxyURL := config.ProxyURL.ValueString()
+	if config.ProxyURL.IsNull() && proxyEnv.HTTPSProxy == "" && proxyEnv.HTTPProxy == "" {
+		proxyURL = settings["proxy_url"]
+	}
+	noProxy := config.NoProxy.ValueString()
+	if config.NoProxy.IsNull() && proxyEnv.NoProxy == "" {
+		noProxy = settings["no_proxy"]
+	}
+
+	// Replace the transport of the client, which only supports skipping
+	// certificate verification, with one using the full TLS and proxy
+	// configuration.
+	baseTransport := http.DefaultTransport.(*http.Transport).Clone()
+	baseTransport.TLSClientConfig = tlsConfig
+	proxySettings{
+		URL:      proxyURL,
+		NoProxy:  noProxy,
+		Username: settings.value(config.ProxyUsername, "FORWARDNETWORKS_PROXY_USERNAME", "proxy_username"),
+		Password: settings.value(config.ProxyPassword, "FORWARDNETWORKS_PROXY_PASSWORD", "proxy_password"),
+	}.configure(baseTransport, &resp.Diagnostics)
+
+	maxRetries := defaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+		if maxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Forward Networks Retry Configuration",
+				"The max_retries value must not be negative.",
+			)
+		}
+	}
+	retryMinWait := durationAttribute(config.RetryMinWait, path.Root("retry_min_wait"), defaultRetryMinWait, &resp.Diagnostics)
+	retryMaxWait := durationAttribute(config.RetryMaxWait, path.Root("retry_max_wait"), defaultRetryMaxWait, &resp.Diagnostics)
+	requestTimeout := durationAttribute(config.RequestTimeout, path.Root("request_timeout"), 0, &resp.Diagnostics)
+	if retryMinWait > retryMaxWait {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"Invalid Forward Networks Retry Configuration",
+			"The retry_min_wait value must not be greater than retry_max_wait.",
+		)
+	}
+
+	// If any of the expected configurations are missing, return
+	// errors with provider-specific guidance.
+
+	if host == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Missing Forward Networks API Host",
+			"The provider cannot create the Forward Networks API client as there is a missing or empty value for the Forward Networks API host. "+
+				"Set the host value in the configuration or use the FORWARDNETWORKS_HOST environment variable. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if apiToken == "" && credentialProcessCommand == "" && username == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("username"),
+			"Missing Forward Networks API Username",
+			"The provider cannot create the Forward Networks API client as there is a missing or empty value for the Forward Networks API username. "+
+				"Set the username value in the configuration or use the FORWARDNETWORKS_USERNAME environment variable, "+
+				"or authenticate with an API token or credential process instead. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if apiToken == "" && credentialProcessCommand == "" && password == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("password"),
+			"Missing Forward Networks API Password",
+			"The provider cannot create the Forward Networks API client as there is a missing or empty value for the Forward Networks API password. "+
+				"Set the password value in the configuration or use the FORWARDNETWORKS_PASSWORD environment variable, "+
+				"or authenticate with an API token or credential process instead. "+
+				"If either is already set, ensure the value is not empty.",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Run the credential process once up front, so that a failing command is
+	// reported when configuring the provider.
+	var process *credentialProcess
+	if credentialProcessCommand != "" {
+		process = &credentialProcess{command: credentialProcessCommand}
+		if _, err := process.retrieve(ctx); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("credential_process"),
+				"Unable to Run Forward Networks Credential Process",
+				"The provider cannot obtain Forward Networks API credentials from the credential process: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	ctx = tflog.SetField(ctx, "forwardnetworks_host", host)
+	ctx = tflog.SetField(ctx, "forwardnetworks_username", username)
+
+	tflog.Debug(ctx, "Creating Forward Networks client")
+
+	// Create a new Forward Networks client using the configuration values.
+	// When authenticating with an API token the client is created without
+	// basic auth credentials and the token is added by the transport.
+	var client *forwardnetworks.Client
+	var err error
+	if apiToken != "" || credentialProcessCommand != "" {
+		client, err = forwardnetworks.NewClient(&host, nil, nil, insecure)
+	} else {
+		client, err = forwardnetworks.NewClient(&host, &username, &password, insecure)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create Forward Networks API Client",
+			"An unexpected error occurred when creating the Forward Networks API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"Forward Networks Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	var transport http.RoundTripper = &proxyErrorTransport{next: baseTransport}
+	if config.DebugHTTP.ValueBool() || os.Getenv("TF_LOG_PROVIDER_FORWARDNETWORKS_HTTP") != "" {
+		transport = newDebugTransport(ctx, transport)
+	}
+	if apiToken != "" {
+		transport = &tokenAuthTransport{
+			token: apiToken,
+			next:  transport,
+		}
+	}
+
+	// The retry transport applies the timeout to each attempt, so the
+	// client-wide timeout, which would span all attempts, is disabled.
+	if requestTimeout == 0 {
+		requestTimeout = client.HTTPClient.Timeout
+	}
+	client.HTTPClient.Timeout = 0
+	transport = &retryTransport{
+		next:       transport,
+		maxRetries: maxRetries,
+		minWait:    retryMinWait,
+		maxWait:    retryMaxWait,
+		timeout:    requestTimeout,
+	}
+
+	// Credentials are obtained once per request rather than per attempt, so
+	// a failing credential process is not run again for every retry.
+	if process != nil {
+		transport = &credentialProcessTransport{
+			process: process,
+			next:    transport,
+		}
+	}
+	client.HTTPClient.Transport = transport
+
+	// Creating the client makes no request, so the proxy connection is
+	// checked here. Otherwise a proxy failure would only surface as an error
+	// of the first resource or data source reading from the API.
+	if err := checkProxy(ctx, baseTransport, host, requestTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Connect to Forward Networks API Through Proxy",
+			"The Forward Networks API client could not connect through the configured proxy. "+
+				"Check the proxy_url, proxy_username and proxy_password values or the HTTPS_PROXY environment variable.\n\n"+
+				"Proxy Error: "+err.Error(),
+		)
+		return
+	}
+
+	// Make the Forward Networks client and the provider defaults available
+	// during DataSource and Resource type Configure methods.
+	data := &providerData{
+		client: client,
+		defaults: &providerDefaults{
+			networkID: settings.value(config.DefaultNetworkID, "FORWARDNETWORKS_DEFAULT_NETWORK_ID", "default_network_id"),
+			snapshot:  settings.value(config.DefaultSnapshot, "FORWARDNETWORKS_DEFAULT_SNAPSHOT", "default_snapshot"),
+		},
+	}
+	resp.DataSourceData = data
+	resp.ResourceData = data
+
+	tflog.Info(ctx, "Configured Forward Networks client", map[string]any{"success": true})
+}
+
+// countTrue returns the number of values that are true.
+func countTrue(values ...bool) int {
+	count := 0
+	for _, value := range values {
+		if value {
+			count++
+		}
+	}
+	return count
+}
+
+// durationAttribute parses an optional duration attribute, returning def when
+// it is null and adding an attribute error when it is not a valid duration.
+func durationAttribute(value types.String, attributePath path.Path, def time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return def
+	}
+
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Duration",
+			"The value must be a non-negative duration such as \"30s\" or \"2m\", got: "+value.ValueString(),
+		)
+		return def
+	}
+	return duration
+}
+
+// DataSources defines the data sources implemented in the provider.
+func (p *forwardnetworksProvider) DataSources(_ context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{
+		NewVersionDataSource,
+		NewExternalIdDataSource,
+		NewSnapshotsDataSource,
+		NewSnapshotExportDataSource,
+		NewNetworksDataSource,
+		NewNqeQueryDataSource,
+		NewCheckResultsDataSource,
+		NewPathSearchDataSource,
+	}
+}
+
+// Resources defines the resources implemented in the provider.
+func (p *forwardnetworksProvider) Resources(_ context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		NewNetworkResource,
+		NewNetworkExternalIdResource,
+		NewSnapshotResource,
+		NewSnapshotImportResource,
+		NewNqeQueryResource,
+		NewIntentCheckResource,
+		NewDeviceCredentialResource,
+		NewClassicDeviceResource,
+		NewClassicDevicesResource,
+	}
+}
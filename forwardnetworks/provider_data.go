@@ -0,0 +1,135 @@
+package forwardnetworks
+
+import (
+	"context"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Snapshot selectors accepted in place of a snapshot ID.
+const (
+	snapshotLatest          = "latest"
+	snapshotLatestProcessed = "latestProcessed"
+)
+
+// providerData is passed by the provider to its data sources and resources.
+type providerData struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// providerDefaults holds the provider-wide defaults that data sources and
+// resources inherit when their own attribute is omitted.
+type providerDefaults struct {
+	networkID string
+	snapshot  string
+}
+
+// resolveNetworkID returns the configured network ID or, when it is null, the
+// default network ID of the provider. It adds an attribute error when
+// neither is set.
+func (d *providerDefaults) resolveNetworkID(value types.String, diags *diag.Diagnostics) types.String {
+	if !value.IsNull() {
+		return value
+	}
+	if d == nil || d.networkID == "" {
+		diags.AddAttributeError(
+			path.Root("network_id"),
+			"Missing Network ID",
+			"The network_id attribute must be set, or a default_network_id must be set in the provider configuration.",
+		)
+		return value
+	}
+	return types.StringValue(d.networkID)
+}
+
+// planNetworkID plans the network_id attribute of a resource: the configured
+// network ID or, when it is null, the default network ID of the provider.
+// Changing the network ID requires the resource to be replaced. It returns
+// the planned network ID, which is unknown when the configured one is.
+func (d *providerDefaults) planNetworkID(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) types.String {
+	var networkID, stateNetworkID types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("network_id"), &networkID)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("network_id"), &stateNetworkID)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return networkID
+	}
+
+	if !networkID.IsUnknown() {
+		networkID = d.resolveNetworkID(networkID, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return networkID
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("network_id"), networkID)...)
+	}
+
+	if !req.State.Raw.IsNull() && !networkID.Equal(stateNetworkID) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("network_id"))
+	}
+	return networkID
+}
+
+// resolveSnapshotID resolves the configured snapshot or, when it is null, the
+// default snapshot of the provider to a snapshot ID. The snapshot is either an
+// ID, latest or latestProcessed, and an empty snapshot ID selects the latest
+// processed snapshot. It adds an attribute error when the latest snapshot
+// cannot be found.
+func (d *providerDefaults) resolveSnapshotID(client *forwardnetworks.Client, networkID string, value types.String, diags *diag.Diagnostics) string {
+	snapshot := value.ValueString()
+	if value.IsNull() && d != nil {
+		snapshot = d.snapshot
+	}
+
+	switch snapshot {
+	case "", snapshotLatestProcessed:
+		return ""
+	case snapshotLatest:
+		return latestSnapshotID(client, networkID, false, diags)
+	default:
+		return snapshot
+	}
+}
+
+// latestSnapshotID returns the ID of the most recently created snapshot of a
+// network, only considering processed snapshots when processed is true. It
+// adds an attribute error when the network has no such snapshot.
+func latestSnapshotID(client *forwardnetworks.Client, networkID string, processed bool, diags *diag.Diagnostics) string {
+	snapshots, err := client.GetSnapshots(networkID)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("snapshot_id"),
+			"Unable to Resolve Forward Networks Snapshot",
+			"Could not read the snapshots of network ID "+networkID+": "+err.Error(),
+		)
+		return ""
+	}
+
+	latest := -1
+	for i, s := range snapshots {
+		if processed && s.State != snapshotStateProcessed {
+			continue
+		}
+		if latest == -1 || s.CreatedAt > snapshots[latest].CreatedAt {
+			latest = i
+		}
+	}
+	if latest == -1 {
+		detail := "Network ID " + networkID + " has no snapshots."
+		if processed {
+			detail = "Network ID " + networkID + " has no processed snapshots."
+		}
+		diags.AddAttributeError(
+			path.Root("snapshot_id"),
+			"Unable to Resolve Forward Networks Snapshot",
+			detail,
+		)
+		return ""
+	}
+	return snapshots[latest].ID
+}
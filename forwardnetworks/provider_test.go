@@ -1,30 +1,348 @@
-package forwardnetworks
-
-import (
-    "github.com/hashicorp/terraform-plugin-framework/providerserver"
-    "github.com/hashicorp/terraform-plugin-go/tfprotov6"
-)
-
-const (
-    // providerConfig is a shared configuration to combine with the actual
-    // test configuration so the Forward Networks client is properly configured.
-    // It is also possible to use the forwardnetworks environment variables instead,
-    // such as updating the Makefile and running the testing through that tool.
-    providerConfig = `
-provider "forwardnetworks" {
-  username = "vle5-wg5wc-64yc"
-  password = "jzjij43fp6vuistufgytfrzomzrijhhu"
-  host     = "https://fwd.app"
-}
-`
-)
-
-var (
-    // testAccProtoV6ProviderFactories are used to instantiate a provider during
-    // acceptance testing. The factory function will be invoked for every Terraform
-    // CLI command executed to create a provider server to which the CLI can
-    // reattach.
-    testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
-        "forwardnetworks": providerserver.NewProtocol6WithError(New()),
-    }
-)
+package forwardnetworks
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var (
+	// testAccProtoV6ProviderFactories are used to instantiate a provider during
+	// acceptance testing. The factory function will be invoked for every Terraform
+	// CLI command executed to create a provider server to which the CLI can
+	// reattach.
+	//
+	// The acceptance tests run against a fakeServer rather than a live Forward
+	// Networks instance, so the provider configuration is obtained from
+	// fakeServer.providerConfig.
+	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"forwardnetworks": providerserver.NewProtocol6WithError(New()),
+	}
+)
+
+func TestAccProvider_apiToken(t *testing.T) {
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "forwardnetworks" {
+  api_token = %q
+  host      = %q
+}
+
+data "forwardnetworks_version" "test" {}
+`, testAPIToken, srv.URL),
+				Check: resource.TestCheckResourceAttrSet("data.forwardnetworks_version.test", "id"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_apiTokenFromEnvironment(t *testing.T) {
+	srv := newFakeServer(t)
+	t.Setenv("FORWARDNETWORKS_API_TOKEN", testAPIToken)
+	t.Setenv("FORWARDNETWORKS_HOST", srv.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "forwardnetworks_version" "test" {}
+`,
+				Check: resource.TestCheckResourceAttrSet("data.forwardnetworks_version.test", "id"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_conflictingCredentials(t *testing.T) {
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "forwardnetworks" {
+  username  = %q
+  api_token = %q
+  host      = %q
+}
+
+data "forwardnetworks_version" "test" {}
+`, testUsername, testAPIToken, srv.URL),
+				ExpectError: regexp.MustCompile("Conflicting Forward Networks API Credentials"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_profile(t *testing.T) {
+	srv := newFakeServer(t)
+	configFile := writeTestConfigFile(t, fmt.Sprintf(`
+[default]
+host = https://fwd.invalid
+
+[test]
+host      = %s
+api_token = %s
+`, srv.URL, testAPIToken))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "forwardnetworks" {
+  profile     = "test"
+  config_file = %q
+}
+
+data "forwardnetworks_version" "test" {}
+`, configFile),
+				Check: resource.TestCheckResourceAttrSet("data.forwardnetworks_version.test", "id"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_profilePrecedence(t *testing.T) {
+	srv := newFakeServer(t)
+	configFile := writeTestConfigFile(t, `
+[default]
+host      = https://fwd.invalid
+api_token = invalid-token
+`)
+	t.Setenv("FORWARDNETWORKS_CONFIG_FILE", configFile)
+	t.Setenv("FORWARDNETWORKS_HOST", srv.URL)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// The host comes from the environment and the
+				// credentials from the configuration.
+				Config: fmt.Sprintf(`
+provider "forwardnetworks" {
+  username = %q
+  password = %q
+}
+
+data "forwardnetworks_version" "test" {}
+`, testUsername, testPassword),
+				Check: resource.TestCheckResourceAttrSet("data.forwardnetworks_version.test", "id"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_missingProfile(t *testing.T) {
+	configFile := writeTestConfigFile(t, `
+[default]
+host = https://fwd.invalid
+`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "forwardnetworks" {
+  profile     = "missing"
+  config_file = %q
+}
+
+data "forwardnetworks_version" "test" {}
+`, configFile),
+				ExpectError: regexp.MustCompile("Unable to Load Forward Networks Profile"),
+			},
+		},
+	})
+}
+
+// writeTestConfigFile writes a provider config file for the test and returns
+// its path.
+func writeTestConfigFile(t *testing.T, content string) string {
+	t.Helper()
+
+	configFile := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(configFile, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return configFile
+}
+
+func TestAccProvider_credentialProcess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the credential process uses a POSIX shell")
+	}
+	srv := newFakeServer(t)
+	script := writeTestCredentialProcess(t, fmt.Sprintf(`echo '{"username": %q, "password": %q}'`, testUsername, testPassword))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "forwardnetworks" {
+  credential_process = %q
+  host               = %q
+}
+
+data "forwardnetworks_version" "test" {}
+`, script, srv.URL),
+				Check: resource.TestCheckResourceAttrSet("data.forwardnetworks_version.test", "id"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_credentialProcessFailure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the credential process uses a POSIX shell")
+	}
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "forwardnetworks" {
+  credential_process = "exit 1"
+  host               = %q
+}
+
+data "forwardnetworks_version" "test" {}
+`, srv.URL),
+				ExpectError: regexp.MustCompile("Unable to Run Forward Networks Credential Process"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_proxy(t *testing.T) {
+	srv := newFakeServer(t)
+	// The fake server answers the requests forwarded by the proxy.
+	proxy := httptest.NewServer(srv)
+	defer proxy.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "forwardnetworks" {
+  api_token = %q
+  host      = "http://fwd.example.com"
+  proxy_url = %q
+}
+
+data "forwardnetworks_version" "test" {}
+`, testAPIToken, proxy.URL),
+				Check: resource.TestCheckResourceAttrSet("data.forwardnetworks_version.test", "id"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_proxyConnectionFailure(t *testing.T) {
+	proxy := httptest.NewServer(http.NotFoundHandler())
+	proxyURL := proxy.URL
+	proxy.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "forwardnetworks" {
+  api_token = %q
+  host      = "https://fwd.example.com"
+  proxy_url = %q
+}
+
+data "forwardnetworks_version" "test" {}
+`, testAPIToken, proxyURL),
+				ExpectError: regexp.MustCompile("Unable to Connect to Forward Networks API Through Proxy"),
+			},
+		},
+	})
+}
+
+func TestAccProvider_defaultNetworkAndSnapshot(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("defaults")
+	srv.addSnapshot(networkID, snapshotStateProcessed, time.Now().Add(-time.Hour))
+	latestID := srv.addSnapshot(networkID, "PROCESSING", time.Now())
+	srv.setNqeResult(testNqeQuery, []map[string]any{{"name": "core-1"}})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "forwardnetworks" {
+  username           = %q
+  password           = %q
+  host               = %q
+  default_network_id = %q
+  default_snapshot   = "latest"
+}
+
+data "forwardnetworks_nqe_query" "test" {
+  query = %q
+}
+
+resource "forwardnetworks_intent_check" "test" {
+  existence {
+    source      = "10.0.0.0/24"
+    destination = "10.1.0.0/24"
+  }
+}
+`, testUsername, testPassword, srv.URL, networkID, testNqeQuery),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_nqe_query.test", "network_id", networkID),
+					resource.TestCheckResourceAttr("data.forwardnetworks_nqe_query.test", "snapshot_id", latestID),
+					resource.TestCheckResourceAttr("forwardnetworks_intent_check.test", "network_id", networkID),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_missingNetworkID(t *testing.T) {
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_intent_check" "test" {
+  existence {
+    source      = "10.0.0.0/24"
+    destination = "10.1.0.0/24"
+  }
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Missing Network ID"),
+			},
+		},
+	})
+}
@@ -0,0 +1,162 @@
+package forwardnetworks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// proxySettings holds the resolved proxy configuration of the provider.
+type proxySettings struct {
+	URL      string
+	NoProxy  string
+	Username string
+	Password string
+}
+
+// configure sets up transport to connect through the configured proxy,
+// adding attribute errors for an invalid proxy URL. Without a configured
+// proxy URL the standard HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment
+// variables are used.
+func (s proxySettings) configure(transport *http.Transport, diags *diag.Diagnostics) {
+	config := httpproxy.FromEnvironment()
+	if s.URL != "" {
+		proxyURL, err := url.Parse(s.URL)
+		if err != nil || proxyURL.Host == "" {
+			diags.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Forward Networks Proxy URL",
+				"The proxy_url value must be an absolute URL such as http://proxy.example.com:3128, got: "+s.URL,
+			)
+			return
+		}
+		switch proxyURL.Scheme {
+		case "http", "https", "socks5":
+		default:
+			diags.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Forward Networks Proxy URL",
+				"The proxy_url scheme must be http, https or socks5, got: "+proxyURL.Scheme,
+			)
+			return
+		}
+		config.HTTPProxy = s.URL
+		config.HTTPSProxy = s.URL
+	}
+	if s.NoProxy != "" {
+		config.NoProxy = s.NoProxy
+	}
+
+	proxyFunc := config.ProxyFunc()
+	transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		proxyURL, err := proxyFunc(req.URL)
+		if err != nil || proxyURL == nil || s.Username == "" {
+			return proxyURL, err
+		}
+		authURL := *proxyURL
+		authURL.User = url.UserPassword(s.Username, s.Password)
+		return &authURL, nil
+	}
+	transport.OnProxyConnectResponse = func(_ context.Context, proxyURL *url.URL, _ *http.Request, resp *http.Response) error {
+		if resp.StatusCode != http.StatusOK {
+			return &proxyError{proxy: proxyURL.Redacted(), err: errors.New(resp.Status)}
+		}
+		return nil
+	}
+}
+
+// checkProxy connects to host through transport when it is configured to use
+// a proxy for host, and returns a proxyError when the proxy cannot be reached
+// or refuses the connection. Other errors are ignored, so they are reported by
+// the API requests that follow.
+func checkProxy(ctx context.Context, transport *http.Transport, host string, timeout time.Duration) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, host, nil)
+	if err != nil || transport.Proxy == nil {
+		return nil
+	}
+	proxyURL, err := transport.Proxy(req)
+	if err != nil {
+		return &proxyError{err: err}
+	}
+	if proxyURL == nil {
+		return nil
+	}
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	resp, err := (&proxyErrorTransport{next: transport}).RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		if isProxyError(err) {
+			return err
+		}
+		return nil
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// proxyError reports a failure to connect to the Forward Networks API
+// through a proxy.
+type proxyError struct {
+	proxy string
+	err   error
+}
+
+func (e *proxyError) Error() string {
+	if e.proxy == "" {
+		return "proxy connection failed: " + e.err.Error()
+	}
+	return "connection through proxy " + e.proxy + " failed: " + e.err.Error()
+}
+
+func (e *proxyError) Unwrap() error {
+	return e.err
+}
+
+// isProxyError reports whether err was caused by a failure to connect to or
+// authenticate with a proxy.
+func isProxyError(err error) bool {
+	var pErr *proxyError
+	if errors.As(err, &pErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "proxyconnect"
+}
+
+// proxyErrorTransport is an http.RoundTripper that reports proxy failures as
+// a proxyError, so they can be told apart from failures of the API itself.
+type proxyErrorTransport struct {
+	next http.RoundTripper
+}
+
+// RoundTrip forwards the request to the wrapped transport, converting proxy
+// connection errors and proxy authentication failures to a proxyError.
+func (t *proxyErrorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := roundTripper(t.next).RoundTrip(req)
+	if err != nil {
+		var pErr *proxyError
+		if isProxyError(err) && !errors.As(err, &pErr) {
+			return nil, &proxyError{err: err}
+		}
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusProxyAuthRequired {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		resp.Body.Close()
+		return nil, &proxyError{err: errors.New(resp.Status)}
+	}
+	return resp, nil
+}
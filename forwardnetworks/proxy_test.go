@@ -0,0 +1,157 @@
+package forwardnetworks
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestProxySettings_forwardsThroughProxy(t *testing.T) {
+	var requested, authorization string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.String()
+		authorization = r.Header.Get("Proxy-Authorization")
+		_, _ = io.WriteString(w, "ok")
+	}))
+	defer proxy.Close()
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	var diags diag.Diagnostics
+	proxySettings{URL: proxy.URL, Username: "proxy-user", Password: "proxy-password"}.configure(transport, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	client := &http.Client{Transport: &proxyErrorTransport{next: transport}}
+	resp, err := client.Get("http://fwd.example.com/api/version")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if requested != "http://fwd.example.com/api/version" {
+		t.Errorf("expected the proxy to receive the API request, got %q", requested)
+	}
+	req := &http.Request{Header: http.Header{}}
+	req.SetBasicAuth("proxy-user", "proxy-password")
+	if authorization != req.Header.Get("Authorization") {
+		t.Errorf("unexpected Proxy-Authorization header %q", authorization)
+	}
+}
+
+func TestProxySettings_noProxy(t *testing.T) {
+	transport := &http.Transport{}
+	var diags diag.Diagnostics
+	proxySettings{URL: "http://proxy.example.com:3128", NoProxy: "internal.example.com,10.0.0.0/8"}.configure(transport, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	for target, proxied := range map[string]bool{
+		"https://fwd.app/api/version":                  true,
+		"https://fwd.internal.example.com/api/version": false,
+		"https://10.1.2.3/api/version":                 false,
+	} {
+		req, err := http.NewRequest(http.MethodGet, target, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		proxyURL, err := transport.Proxy(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (proxyURL != nil) != proxied {
+			t.Errorf("%s: expected proxied to be %t, got proxy %v", target, proxied, proxyURL)
+		}
+	}
+}
+
+func TestProxySettings_invalidURL(t *testing.T) {
+	for _, proxyURL := range []string{"proxy.example.com:3128", "ftp://proxy.example.com", "http://[::1"} {
+		var diags diag.Diagnostics
+		proxySettings{URL: proxyURL}.configure(&http.Transport{}, &diags)
+		if !diags.HasError() {
+			t.Errorf("%s: expected an error", proxyURL)
+		}
+	}
+}
+
+func TestProxyErrorTransport_connectionFailure(t *testing.T) {
+	proxy := httptest.NewServer(http.NotFoundHandler())
+	proxyURL := proxy.URL
+	proxy.Close()
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	var diags diag.Diagnostics
+	proxySettings{URL: proxyURL}.configure(transport, &diags)
+
+	client := &http.Client{Transport: &proxyErrorTransport{next: transport}}
+	_, err := client.Get("http://fwd.example.com/api/version")
+	if !isProxyError(err) {
+		t.Fatalf("expected a proxy error, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "proxy connection failed") {
+		t.Errorf("unexpected error message %q", err)
+	}
+}
+
+func TestProxyErrorTransport_authenticationRequired(t *testing.T) {
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusProxyAuthRequired)
+	}))
+	defer proxy.Close()
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	var diags diag.Diagnostics
+	proxySettings{URL: proxy.URL}.configure(transport, &diags)
+
+	client := &http.Client{Transport: &proxyErrorTransport{next: transport}}
+	_, err := client.Get("http://fwd.example.com/api/version")
+	if !isProxyError(err) {
+		t.Fatalf("expected a proxy error, got %v", err)
+	}
+
+	// Tunneled HTTPS requests fail while establishing the tunnel.
+	_, err = client.Get("https://fwd.example.com/api/version")
+	if !isProxyError(err) {
+		t.Fatalf("expected a proxy error, got %v", err)
+	}
+}
+
+func TestCheckProxy(t *testing.T) {
+	proxy := httptest.NewServer(http.NotFoundHandler())
+	defer proxy.Close()
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closedURL := closed.URL
+	closed.Close()
+
+	for name, test := range map[string]struct {
+		settings proxySettings
+		fails    bool
+	}{
+		"reachable":   {settings: proxySettings{URL: proxy.URL}},
+		"unreachable": {settings: proxySettings{URL: closedURL}, fails: true},
+		"no proxy":    {settings: proxySettings{URL: closedURL, NoProxy: "fwd.example.com"}},
+	} {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		var diags diag.Diagnostics
+		test.settings.configure(transport, &diags)
+		if diags.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", name, diags)
+		}
+
+		err := checkProxy(context.Background(), transport, "http://fwd.example.com", time.Second)
+		if test.fails && !isProxyError(err) {
+			t.Errorf("%s: expected a proxy error, got %v", name, err)
+		}
+		if !test.fails && err != nil {
+			t.Errorf("%s: unexpected error: %s", name, err)
+		}
+	}
+}
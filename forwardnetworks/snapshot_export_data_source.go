@@ -0,0 +1,180 @@
+package forwardnetworks
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &snapshotExportDataSource{}
+	_ datasource.DataSourceWithConfigure = &snapshotExportDataSource{}
+)
+
+// NewSnapshotExportDataSource is a helper function to simplify the provider implementation.
+func NewSnapshotExportDataSource() datasource.DataSource {
+	return &snapshotExportDataSource{}
+}
+
+// snapshotExportDataSource is the data source implementation.
+type snapshotExportDataSource struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// snapshotExportDataSourceModel maps the data source schema data.
+type snapshotExportDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	NetworkID     types.String `tfsdk:"network_id"`
+	SnapshotID    types.String `tfsdk:"snapshot_id"`
+	OutputPath    types.String `tfsdk:"output_path"`
+	ContentSHA256 types.String `tfsdk:"content_sha256"`
+	Size          types.Int64  `tfsdk:"size"`
+}
+
+// Metadata returns the data source type name.
+func (d *snapshotExportDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_export"
+}
+
+// Schema defines the schema for the data source.
+func (d *snapshotExportDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Downloads the archive of a Forward Networks snapshot to a local file. " +
+			"The archive is streamed to disk, so it is never held in memory, and is downloaded again each time the data source is read.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the exported snapshot.",
+				Computed:    true,
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The network ID whose snapshot is exported. Defaults to the default_network_id of the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"snapshot_id": schema.StringAttribute{
+				Description: "The snapshot ID to export. May also be latest or latestProcessed. Defaults to the default_snapshot of the provider, or the latest processed snapshot of the network; once read, holds the exported snapshot.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"output_path": schema.StringAttribute{
+				Description: "Path of the .zip file the archive is written to. Missing directories are created, and an existing file is replaced once the download completes. The file is only readable by its owner.",
+				Required:    true,
+			},
+			"content_sha256": schema.StringAttribute{
+				Description: "Hex-encoded SHA-256 hash of the downloaded archive.",
+				Computed:    true,
+			},
+			"size": schema.Int64Attribute{
+				Description: "Size of the downloaded archive, in bytes.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *snapshotExportDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.defaults = data.defaults
+}
+
+// Read downloads the snapshot archive and refreshes the Terraform state.
+func (d *snapshotExportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state snapshotExportDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.NetworkID = d.defaults.resolveNetworkID(state.NetworkID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	snapshotID := d.defaults.resolveSnapshotID(d.client, state.NetworkID.ValueString(), state.SnapshotID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The export API needs the ID of the latest processed snapshot.
+	if snapshotID == "" {
+		snapshotID = latestSnapshotID(d.client, state.NetworkID.ValueString(), true, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	hash, size, err := d.download(snapshotID, state.OutputPath.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Export Forward Networks Snapshot",
+			"Could not export snapshot ID "+snapshotID+" to "+state.OutputPath.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(snapshotID)
+	state.SnapshotID = types.StringValue(snapshotID)
+	state.ContentSHA256 = types.StringValue(hash)
+	state.Size = types.Int64Value(size)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// download streams the archive of a snapshot to outputPath and returns its
+// hex-encoded SHA-256 hash and size. The archive is written to a temporary
+// file next to outputPath that replaces it once complete, so a failed
+// download never leaves a truncated archive behind.
+func (d *snapshotExportDataSource) download(snapshotID, outputPath string) (string, int64, error) {
+	dir := filepath.Dir(outputPath)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", 0, err
+	}
+
+	archive, err := d.client.ExportSnapshot(snapshotID)
+	if err != nil {
+		return "", 0, err
+	}
+	defer archive.Close()
+
+	file, err := os.CreateTemp(dir, "."+filepath.Base(outputPath)+".*.tmp")
+	if err != nil {
+		return "", 0, err
+	}
+	defer os.Remove(file.Name())
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(file, hash), archive)
+	if err != nil {
+		file.Close()
+		return "", 0, err
+	}
+	if err := file.Close(); err != nil {
+		return "", 0, err
+	}
+	if err := os.Rename(file.Name(), outputPath); err != nil {
+		return "", 0, err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
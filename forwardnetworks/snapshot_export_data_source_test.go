@@ -0,0 +1,134 @@
+package forwardnetworks
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSnapshotExportDataSource(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("export")
+	base := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	older := srv.addSnapshot(networkID, snapshotStateProcessed, base)
+	processed := srv.addSnapshot(networkID, snapshotStateProcessed, base.Add(24*time.Hour))
+	srv.addSnapshot(networkID, snapshotStateFailed, base.Add(48*time.Hour))
+
+	// The archives span several chunks of the streamed response.
+	olderArchive := append([]byte("PK\x03\x04"), bytes.Repeat([]byte("older"), 1000)...)
+	processedArchive := append([]byte("PK\x03\x04"), bytes.Repeat([]byte("processed"), 1000)...)
+	srv.setSnapshotArchive(older, olderArchive)
+	srv.setSnapshotArchive(processed, processedArchive)
+
+	dir := t.TempDir()
+	output := filepath.Join(dir, "archive", "snapshot.zip")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The latest processed snapshot is exported by default
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_snapshot_export" "test" {
+  network_id  = "` + networkID + `"
+  output_path = "` + filepath.ToSlash(output) + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshot_export.test", "id", processed),
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshot_export.test", "snapshot_id", processed),
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshot_export.test", "content_sha256", testSHA256(processedArchive)),
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshot_export.test", "size", strconv.Itoa(len(processedArchive))),
+					testCheckFileContent(output, processedArchive),
+				),
+			},
+			// An existing archive is replaced
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_snapshot_export" "test" {
+  network_id  = "` + networkID + `"
+  snapshot_id = "` + older + `"
+  output_path = "` + filepath.ToSlash(output) + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshot_export.test", "id", older),
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshot_export.test", "content_sha256", testSHA256(olderArchive)),
+					testCheckFileContent(output, olderArchive),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotExportDataSource_failed(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("export")
+	failed := srv.addSnapshot(networkID, snapshotStateFailed, time.Now())
+
+	output := filepath.Join(t.TempDir(), "snapshot.zip")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_snapshot_export" "test" {
+  network_id  = "` + networkID + `"
+  output_path = "` + filepath.ToSlash(output) + `"
+}
+`,
+				ExpectError: regexp.MustCompile(`has no processed snapshots`),
+			},
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_snapshot_export" "test" {
+  network_id  = "` + networkID + `"
+  snapshot_id = "` + failed + `"
+  output_path = "` + filepath.ToSlash(output) + `"
+}
+`,
+				ExpectError: regexp.MustCompile(`Unable to Export Forward Networks Snapshot`),
+			},
+		},
+	})
+
+	// A failed export leaves no partial archive behind.
+	entries, err := os.ReadDir(filepath.Dir(output))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("expected no files after a failed export, got %d", len(entries))
+	}
+}
+
+// testSHA256 returns the hex-encoded SHA-256 hash of data.
+func testSHA256(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// testCheckFileContent checks that the file at name holds content.
+func testCheckFileContent(name string, content []byte) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(data, content) {
+			return fmt.Errorf("unexpected content of %s: got %d bytes, want %d", name, len(data), len(content))
+		}
+		return nil
+	}
+}
@@ -0,0 +1,358 @@
+package forwardnetworks
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// zipSignature is the signature at the start of a zip archive.
+var zipSignature = []byte("PK\x03\x04")
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &snapshotImportResource{}
+	_ resource.ResourceWithConfigure  = &snapshotImportResource{}
+	_ resource.ResourceWithModifyPlan = &snapshotImportResource{}
+)
+
+// NewSnapshotImportResource is a helper function to simplify the provider implementation.
+func NewSnapshotImportResource() resource.Resource {
+	return &snapshotImportResource{}
+}
+
+// snapshotImportResource is the resource implementation.
+type snapshotImportResource struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// snapshotImportResourceModel maps the resource schema data.
+type snapshotImportResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	NetworkID     types.String   `tfsdk:"network_id"`
+	Source        types.String   `tfsdk:"source"`
+	SourceHash    types.String   `tfsdk:"source_hash"`
+	ContentSHA256 types.String   `tfsdk:"content_sha256"`
+	State         types.String   `tfsdk:"state"`
+	CreatedAt     types.Int64    `tfsdk:"created_at"`
+	ProcessedAt   types.Int64    `tfsdk:"processed_at"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *snapshotImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot_import"
+}
+
+// Schema defines the schema for the resource.
+func (r *snapshotImportResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads a snapshot archive exported from Forward Networks to a network and waits until it is processed. " +
+			"The archive is uploaded again when its content changes. Destroying the resource leaves the snapshot in place. " +
+			"Waits up to 30 minutes for the snapshot to be processed unless timeouts.create is set; the upload itself is not limited.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the snapshot.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The network to upload the snapshot to. Defaults to the default_network_id of the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "Path of the .zip snapshot archive to upload. The archive is streamed, so it is never held in memory.",
+				Required:    true,
+			},
+			"source_hash": schema.StringAttribute{
+				Description: "Value that changes when the content of source changes, such as filesha256(source). " +
+					"When set, the archive is not hashed while planning and a new snapshot is only uploaded when source or source_hash changes.",
+				Optional: true,
+			},
+			"content_sha256": schema.StringAttribute{
+				Description: "Hex-encoded SHA-256 hash of the uploaded archive. Unless source_hash is set, a new snapshot is uploaded when the content of source changes. " +
+					"The hash in the state is kept when source is unchanged and the archive no longer exists.",
+				Computed: true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Processing state of the snapshot.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Creation time of the snapshot, in milliseconds since the Unix epoch.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"processed_at": schema.Int64Attribute{
+				Description: "Time the snapshot finished processing, in milliseconds since the Unix epoch.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *snapshotImportResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.defaults = data.defaults
+}
+
+// ModifyPlan fills in the network ID from the default_network_id of the
+// provider when it is omitted, hashes the archive unless source_hash is set,
+// and uploads a new snapshot when the network or the content of the archive
+// changes.
+func (r *snapshotImportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the snapshot is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, state snapshotImportResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.defaults.planNetworkID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	contentSHA256, diags := planContentSHA256(config, state, req.State.Raw.IsNull())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), contentSHA256)...)
+
+	if !req.State.Raw.IsNull() && !contentSHA256.Equal(state.ContentSHA256) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_sha256"))
+	}
+}
+
+// planContentSHA256 returns the planned content_sha256 of an archive. The
+// hash of an archive that is not known yet, such as one written by another
+// resource or tracked by source_hash, is only known once it is uploaded.
+func planContentSHA256(config, state snapshotImportResourceModel, creating bool) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	unchanged := !creating && config.Source.Equal(state.Source)
+	switch {
+	case config.Source.IsUnknown():
+		return types.StringUnknown(), diags
+	case !config.SourceHash.IsNull():
+		if unchanged && config.SourceHash.Equal(state.SourceHash) {
+			return state.ContentSHA256, diags
+		}
+		return types.StringUnknown(), diags
+	}
+
+	hash, err := archiveSHA256(config.Source.ValueString())
+	if errors.Is(err, fs.ErrNotExist) && unchanged {
+		// The archive was removed after it was uploaded.
+		return state.ContentSHA256, diags
+	}
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("source"),
+			"Invalid Snapshot Archive",
+			"Could not read snapshot archive "+config.Source.ValueString()+": "+err.Error(),
+		)
+		return types.StringUnknown(), diags
+	}
+	return types.StringValue(hash), diags
+}
+
+// Create uploads the archive, waits for the snapshot to be processed and sets
+// the initial Terraform state.
+func (r *snapshotImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan snapshotImportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultSnapshotCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Upload the archive, hashing it as it is streamed
+	archive, err := os.Open(plan.Source.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Snapshot Import",
+			"Could not open snapshot archive "+plan.Source.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	defer archive.Close()
+
+	hash := sha256.New()
+	snapshot, err := r.client.ImportSnapshot(plan.NetworkID.ValueString(), filepath.Base(plan.Source.ValueString()), io.TeeReader(archive, hash))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Snapshot Import",
+			"Could not upload snapshot archive "+plan.Source.ValueString()+" to network ID "+plan.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	contentSHA256 := hex.EncodeToString(hash.Sum(nil))
+	if !plan.ContentSHA256.IsUnknown() && plan.ContentSHA256.ValueString() != contentSHA256 {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Snapshot Import",
+			"Snapshot archive "+plan.Source.ValueString()+" changed after the plan was created. "+
+				"The uploaded content was saved as snapshot "+snapshot.ID+"; apply again to upload the current content.",
+		)
+	}
+	plan.ContentSHA256 = types.StringValue(contentSHA256)
+
+	// The snapshot is saved even when it is not processed, so that it is
+	// tainted and uploaded again by the next apply.
+	snapshot, err = waitForSnapshot(ctx, r.client, snapshot, timeout)
+	plan.refresh(snapshot)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Snapshot Import",
+			"Snapshot "+snapshot.ID+" uploaded to network ID "+plan.NetworkID.ValueString()+" was not processed: "+err.Error(),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *snapshotImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state snapshotImportResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed snapshot value from Forward Networks
+	snapshot, err := r.client.GetSnapshot(state.ID.ValueString())
+	if errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Forward Networks Snapshot Import",
+			"Could not read snapshot ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite attributes with refreshed state
+	state.refresh(snapshot)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only stores a moved source or changed timeouts, as a change of
+// content uploads a new snapshot.
+func (r *snapshotImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan snapshotImportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state. Snapshots are part of
+// the history of the network, so the snapshot itself is kept.
+func (r *snapshotImportResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// refresh copies the API representation of a snapshot into the model.
+func (m *snapshotImportResourceModel) refresh(snapshot *forwardnetworks.Snapshot) {
+	m.ID = types.StringValue(snapshot.ID)
+	m.State = types.StringValue(snapshot.State)
+	m.CreatedAt = types.Int64Value(snapshot.CreatedAt)
+	if snapshot.ProcessedAt != 0 {
+		m.ProcessedAt = types.Int64Value(snapshot.ProcessedAt)
+	} else {
+		m.ProcessedAt = types.Int64Null()
+	}
+}
+
+// archiveSHA256 returns the hex-encoded SHA-256 hash of the zip archive at
+// name, reading it in chunks.
+func archiveSHA256(name string) (string, error) {
+	archive, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer archive.Close()
+
+	signature := make([]byte, len(zipSignature))
+	if _, err := io.ReadFull(archive, signature); err != nil || !bytes.Equal(signature, zipSignature) {
+		return "", errors.New("not a zip archive")
+	}
+
+	hash := sha256.New()
+	hash.Write(signature)
+	if _, err := io.Copy(hash, archive); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
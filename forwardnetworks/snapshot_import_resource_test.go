@@ -0,0 +1,246 @@
+package forwardnetworks
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSnapshotImportResource(t *testing.T) {
+	setSnapshotPollInterval(t, time.Millisecond)
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("lab")
+
+	dir := t.TempDir()
+	archive := filepath.Join(dir, "lab.zip")
+	firstHash := writeTestSnapshotArchive(t, archive, "v1")
+	moved := filepath.Join(dir, "lab-moved.zip")
+
+	config := func(source string) string {
+		return srv.providerConfig() + `
+resource "forwardnetworks_snapshot_import" "test" {
+  network_id = "` + networkID + `"
+  source     = "` + filepath.ToSlash(source) + `"
+}
+`
+	}
+
+	var firstID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config(archive),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_snapshot_import.test", "content_sha256", firstHash),
+					resource.TestCheckResourceAttr("forwardnetworks_snapshot_import.test", "state", snapshotStateProcessed),
+					resource.TestCheckResourceAttrSet("forwardnetworks_snapshot_import.test", "processed_at"),
+					func(s *terraform.State) error {
+						firstID = s.RootModule().Resources["forwardnetworks_snapshot_import.test"].Primary.ID
+						upload := srv.upload(firstID)
+						if upload.sha256 != firstHash || upload.filename != "lab.zip" {
+							return fmt.Errorf("unexpected upload %+v", upload)
+						}
+						if !upload.streamed {
+							return fmt.Errorf("expected the archive to be streamed")
+						}
+						return nil
+					},
+				),
+			},
+			// Moving the archive does not upload it again
+			{
+				PreConfig: func() {
+					if err := os.Rename(archive, moved); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config(moved),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forwardnetworks_snapshot_import.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrPtr("forwardnetworks_snapshot_import.test", "id", &firstID),
+			},
+			// Removing the uploaded archive does not change the snapshot
+			{
+				PreConfig: func() {
+					if err := os.Remove(moved); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: config(moved),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("forwardnetworks_snapshot_import.test", "content_sha256", firstHash),
+			},
+			// Changing the content uploads a new snapshot
+			{
+				PreConfig: func() {
+					writeTestSnapshotArchive(t, moved, "v2")
+				},
+				Config: config(moved),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forwardnetworks_snapshot_import.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources["forwardnetworks_snapshot_import.test"]
+					if rs.Primary.ID == firstID {
+						return fmt.Errorf("expected a new snapshot, got %s again", firstID)
+					}
+					if hash := rs.Primary.Attributes["content_sha256"]; hash == firstHash || srv.upload(rs.Primary.ID).sha256 != hash {
+						return fmt.Errorf("expected the new content to be uploaded, got hash %s", hash)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccSnapshotImportResource_sourceHash(t *testing.T) {
+	setSnapshotPollInterval(t, time.Millisecond)
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("lab")
+
+	archive := filepath.Join(t.TempDir(), "lab.zip")
+	firstHash := writeTestSnapshotArchive(t, archive, "v1")
+
+	config := func(sourceHash string) string {
+		return srv.providerConfig() + `
+resource "forwardnetworks_snapshot_import" "test" {
+  network_id  = "` + networkID + `"
+  source      = "` + filepath.ToSlash(archive) + `"
+  source_hash = "` + sourceHash + `"
+}
+`
+	}
+
+	var firstID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_snapshot_import.test", "content_sha256", firstHash),
+					func(s *terraform.State) error {
+						firstID = s.RootModule().Resources["forwardnetworks_snapshot_import.test"].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Changing the content without changing source_hash is ignored
+			{
+				PreConfig: func() {
+					writeTestSnapshotArchive(t, archive, "v2")
+				},
+				Config: config("v1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+				Check: resource.TestCheckResourceAttrPtr("forwardnetworks_snapshot_import.test", "id", &firstID),
+			},
+			// Changing source_hash uploads a new snapshot
+			{
+				Config: config("v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forwardnetworks_snapshot_import.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					rs := s.RootModule().Resources["forwardnetworks_snapshot_import.test"]
+					if rs.Primary.ID == firstID || rs.Primary.Attributes["content_sha256"] == firstHash {
+						return fmt.Errorf("expected the new content to be uploaded, got snapshot %s", rs.Primary.ID)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccSnapshotImportResource_invalidArchive(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("lab")
+
+	invalid := filepath.Join(t.TempDir(), "snapshot.zip")
+	if err := os.WriteFile(invalid, []byte("not a zip archive"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_snapshot_import" "test" {
+  network_id = "` + networkID + `"
+  source     = "` + filepath.ToSlash(invalid) + `"
+}
+`,
+				ExpectError: regexp.MustCompile(`not a zip archive`),
+			},
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_snapshot_import" "test" {
+  network_id = "` + networkID + `"
+  source     = "` + filepath.ToSlash(filepath.Join(t.TempDir(), "missing.zip")) + `"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Snapshot Archive`),
+			},
+		},
+	})
+}
+
+// writeTestSnapshotArchive writes a zip archive containing content to name
+// and returns its hex-encoded SHA-256 hash.
+func writeTestSnapshotArchive(t *testing.T, name, content string) string {
+	t.Helper()
+
+	file, err := os.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	writer := zip.NewWriter(file)
+	entry, err := writer.Create("snapshot.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := entry.Write([]byte(`{"version":"` + content + `"}`)); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,325 @@
+package forwardnetworks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// snapshotStateFailed is the state of a snapshot whose collection or
+// processing failed.
+const snapshotStateFailed = "FAILED"
+
+// defaultSnapshotCreateTimeout is how long to wait for a snapshot to be
+// processed when no create timeout is configured.
+const defaultSnapshotCreateTimeout = 30 * time.Minute
+
+// snapshotPollInterval is how often the state of a snapshot is read while
+// waiting for it to be processed.
+var snapshotPollInterval = 10 * time.Second
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &snapshotResource{}
+	_ resource.ResourceWithConfigure   = &snapshotResource{}
+	_ resource.ResourceWithModifyPlan  = &snapshotResource{}
+	_ resource.ResourceWithImportState = &snapshotResource{}
+)
+
+// NewSnapshotResource is a helper function to simplify the provider implementation.
+func NewSnapshotResource() resource.Resource {
+	return &snapshotResource{}
+}
+
+// snapshotResource is the resource implementation.
+type snapshotResource struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// snapshotResourceModel maps the resource schema data.
+type snapshotResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	NetworkID   types.String   `tfsdk:"network_id"`
+	Devices     types.Set      `tfsdk:"devices"`
+	Triggers    types.Map      `tfsdk:"triggers"`
+	State       types.String   `tfsdk:"state"`
+	CreatedAt   types.Int64    `tfsdk:"created_at"`
+	ProcessedAt types.Int64    `tfsdk:"processed_at"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+}
+
+// Metadata returns the resource type name.
+func (r *snapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshot"
+}
+
+// Schema defines the schema for the resource.
+func (r *snapshotResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Collects a new snapshot of a Forward Networks network and waits until it is processed. " +
+			"Changing any argument other than timeouts collects a new snapshot. Destroying the resource leaves the snapshot in place. " +
+			"Waits up to 30 minutes for the snapshot to be processed unless timeouts.create is set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identifier of the snapshot.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The network to collect. Defaults to the default_network_id of the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"devices": schema.SetAttribute{
+				Description: "Names of the devices to collect. All devices of the network are collected if omitted.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that collect a new snapshot when they change, such as the IDs of resources changing device configuration.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "Processing state of the snapshot.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.Int64Attribute{
+				Description: "Creation time of the snapshot, in milliseconds since the Unix epoch.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"processed_at": schema.Int64Attribute{
+				Description: "Time the snapshot finished processing, in milliseconds since the Unix epoch.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *snapshotResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	r.client = data.client
+	r.defaults = data.defaults
+}
+
+// ModifyPlan fills in the network ID from the default_network_id of the
+// provider when it is omitted, and collects a new snapshot when the network
+// changes.
+func (r *snapshotResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the snapshot is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.defaults.planNetworkID(ctx, req, resp)
+}
+
+// Create collects a new snapshot, waits for it to be processed and sets the
+// initial Terraform state.
+func (r *snapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan snapshotResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout, diags := plan.Timeouts.Create(ctx, defaultSnapshotCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	var request forwardnetworks.CollectionRequest
+	if !plan.Devices.IsNull() {
+		resp.Diagnostics.Append(plan.Devices.ElementsAs(ctx, &request.Devices, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Start the collection
+	snapshot, err := r.client.StartCollection(plan.NetworkID.ValueString(), request)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Snapshot",
+			"Could not start collection of network ID "+plan.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// The snapshot is saved even when it is not processed, so that it is
+	// tainted and collected again by the next apply.
+	snapshot, err = waitForSnapshot(ctx, r.client, snapshot, timeout)
+	plan.refresh(snapshot)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Forward Networks Snapshot",
+			"Snapshot "+snapshot.ID+" of network ID "+plan.NetworkID.ValueString()+" was not processed: "+err.Error(),
+		)
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *snapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state snapshotResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get refreshed snapshot value from Forward Networks
+	snapshot, err := r.client.GetSnapshot(state.ID.ValueString())
+	if errors.Is(err, forwardnetworks.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Forward Networks Snapshot",
+			"Could not read snapshot ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite attributes with refreshed state
+	state.refresh(snapshot)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only stores changed timeouts, as every other change collects a new
+// snapshot.
+func (r *snapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan snapshotResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the resource from the Terraform state. Snapshots are part of
+// the history of the network, so the snapshot itself is kept.
+func (r *snapshotResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState imports an existing snapshot using an import ID of the form
+// network_id/snapshot_id.
+func (r *snapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	networkID, snapshotID, ok := strings.Cut(req.ID, "/")
+	if !ok || networkID == "" || snapshotID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			"Expected import identifier with format network_id/snapshot_id, got: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("network_id"), networkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), snapshotID)...)
+}
+
+// refresh copies the API representation of a snapshot into the model.
+func (m *snapshotResourceModel) refresh(snapshot *forwardnetworks.Snapshot) {
+	m.ID = types.StringValue(snapshot.ID)
+	m.State = types.StringValue(snapshot.State)
+	m.CreatedAt = types.Int64Value(snapshot.CreatedAt)
+	if snapshot.ProcessedAt != 0 {
+		m.ProcessedAt = types.Int64Value(snapshot.ProcessedAt)
+	} else {
+		m.ProcessedAt = types.Int64Null()
+	}
+}
+
+// waitForSnapshot polls a snapshot until it is processed, fails to process,
+// timeout elapses or ctx is cancelled. It returns the most recently read
+// snapshot along with an error when the snapshot was not processed.
+func waitForSnapshot(ctx context.Context, client *forwardnetworks.Client, snapshot *forwardnetworks.Snapshot, timeout time.Duration) (*forwardnetworks.Snapshot, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		switch snapshot.State {
+		case snapshotStateProcessed:
+			return snapshot, nil
+		case snapshotStateFailed:
+			return snapshot, errors.New("processing failed")
+		}
+
+		tflog.Debug(ctx, "Waiting for snapshot to be processed", map[string]any{
+			"snapshot_id": snapshot.ID,
+			"state":       snapshot.State,
+		})
+
+		select {
+		case <-waitCtx.Done():
+			// ctx is cancelled when Terraform is interrupted.
+			if err := ctx.Err(); err != nil {
+				return snapshot, fmt.Errorf("interrupted in state %s: %w", snapshot.State, err)
+			}
+			return snapshot, fmt.Errorf("timed out after %s in state %s", timeout, snapshot.State)
+		case <-time.After(snapshotPollInterval):
+		}
+
+		next, err := client.GetSnapshot(snapshot.ID)
+		if err != nil {
+			return snapshot, err
+		}
+		snapshot = next
+	}
+}
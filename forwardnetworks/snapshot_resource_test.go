@@ -0,0 +1,198 @@
+package forwardnetworks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccSnapshotResource(t *testing.T) {
+	setSnapshotPollInterval(t, time.Millisecond)
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("snapshot")
+	srv.setCollectionResult(2, snapshotStateProcessed)
+
+	var firstID string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_snapshot" "test" {
+  network_id = "` + networkID + `"
+  devices    = ["edge-1"]
+
+  triggers = {
+    config = "v1"
+  }
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_snapshot.test", "state", snapshotStateProcessed),
+					resource.TestCheckResourceAttrSet("forwardnetworks_snapshot.test", "created_at"),
+					resource.TestCheckResourceAttrSet("forwardnetworks_snapshot.test", "processed_at"),
+					func(s *terraform.State) error {
+						firstID = s.RootModule().Resources["forwardnetworks_snapshot.test"].Primary.ID
+						if devices := srv.lastCollection().Devices; len(devices) != 1 || devices[0] != "edge-1" {
+							return fmt.Errorf("expected edge-1 to be collected, got %v", devices)
+						}
+						return nil
+					},
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "forwardnetworks_snapshot.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return networkID + "/" + s.RootModule().Resources["forwardnetworks_snapshot.test"].Primary.ID, nil
+				},
+				ImportStateVerifyIgnore: []string{"devices", "triggers"},
+			},
+			// Changing the timeouts does not collect a new snapshot
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_snapshot" "test" {
+  network_id = "` + networkID + `"
+  devices    = ["edge-1"]
+
+  triggers = {
+    config = "v1"
+  }
+
+  timeouts = {
+    create = "45m"
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forwardnetworks_snapshot.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrPtr("forwardnetworks_snapshot.test", "id", &firstID),
+			},
+			// Changing the triggers collects a new snapshot
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_snapshot" "test" {
+  network_id = "` + networkID + `"
+
+  triggers = {
+    config = "v2"
+  }
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("forwardnetworks_snapshot.test", plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("forwardnetworks_snapshot.test", "state", snapshotStateProcessed),
+					func(s *terraform.State) error {
+						if id := s.RootModule().Resources["forwardnetworks_snapshot.test"].Primary.ID; id == firstID {
+							return fmt.Errorf("expected a new snapshot, got %s again", id)
+						}
+						if devices := srv.lastCollection().Devices; len(devices) != 0 {
+							return fmt.Errorf("expected all devices to be collected, got %v", devices)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccSnapshotResource_failure(t *testing.T) {
+	setSnapshotPollInterval(t, time.Millisecond)
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("snapshot")
+
+	config := srv.providerConfig() + `
+resource "forwardnetworks_snapshot" "test" {
+  network_id = "` + networkID + `"
+
+  timeouts = {
+    create = "100ms"
+  }
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				PreConfig:   func() { srv.setCollectionResult(1, snapshotStateFailed) },
+				Config:      config,
+				ExpectError: regexp.MustCompile(`processing failed`),
+			},
+			{
+				PreConfig:   func() { srv.setCollectionResult(1000000, snapshotStateProcessed) },
+				Config:      config,
+				ExpectError: regexp.MustCompile(`timed out after 100ms in state PROCESSING`),
+			},
+			{
+				Config: srv.providerConfig() + `
+resource "forwardnetworks_snapshot" "test" {
+  network_id = "` + networkID + `"
+
+  timeouts = {
+    create = "soon"
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Time Duration`),
+			},
+		},
+	})
+}
+
+func TestWaitForSnapshot_interrupted(t *testing.T) {
+	setSnapshotPollInterval(t, time.Millisecond)
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("snapshot")
+	snapshotID := srv.addSnapshot(networkID, "PROCESSING", time.Now())
+
+	username, password := testUsername, testPassword
+	client, err := forwardnetworks.NewClient(&srv.URL, &username, &password, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	snapshot, err := client.GetSnapshot(snapshotID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, interrupt := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, interrupt)
+
+	_, err = waitForSnapshot(ctx, client, snapshot, time.Hour)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("got error %v, want %v", err, context.Canceled)
+	}
+	if strings.Contains(err.Error(), "timed out") {
+		t.Errorf("interrupted wait reported as a timeout: %v", err)
+	}
+}
+
+// setSnapshotPollInterval changes how often snapshots are polled for the
+// duration of a test.
+func setSnapshotPollInterval(t *testing.T, interval time.Duration) {
+	t.Helper()
+
+	previous := snapshotPollInterval
+	snapshotPollInterval = interval
+	t.Cleanup(func() { snapshotPollInterval = previous })
+}
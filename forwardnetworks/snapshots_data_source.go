@@ -0,0 +1,232 @@
+package forwardnetworks
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// snapshotStateProcessed is the state of a snapshot that has been fully
+// processed and can be queried.
+const snapshotStateProcessed = "PROCESSED"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &snapshotsDataSource{}
+	_ datasource.DataSourceWithConfigure = &snapshotsDataSource{}
+)
+
+// NewSnapshotsDataSource is a helper function to simplify the provider implementation.
+func NewSnapshotsDataSource() datasource.DataSource {
+	return &snapshotsDataSource{}
+}
+
+// snapshotsDataSource is the data source implementation.
+type snapshotsDataSource struct {
+	client   *forwardnetworks.Client
+	defaults *providerDefaults
+}
+
+// snapshotsDataSourceModel maps the data source schema data.
+type snapshotsDataSourceModel struct {
+	ID                types.String    `tfsdk:"id"`
+	NetworkID         types.String    `tfsdk:"network_id"`
+	State             types.String    `tfsdk:"state"`
+	CreatedAfter      types.String    `tfsdk:"created_after"`
+	CreatedBefore     types.String    `tfsdk:"created_before"`
+	LatestProcessedID types.String    `tfsdk:"latest_processed_id"`
+	Snapshots         []snapshotModel `tfsdk:"snapshots"`
+}
+
+// snapshotModel maps snapshot data.
+type snapshotModel struct {
+	ID          types.String `tfsdk:"id"`
+	State       types.String `tfsdk:"state"`
+	CreatedAt   types.Int64  `tfsdk:"created_at"`
+	ProcessedAt types.Int64  `tfsdk:"processed_at"`
+	IsLatest    types.Bool   `tfsdk:"is_latest"`
+}
+
+// Metadata returns the data source type name.
+func (d *snapshotsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_snapshots"
+}
+
+// Schema defines the schema for the data source.
+func (d *snapshotsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the snapshots of a Forward Networks network.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Placeholder identifier attribute.",
+				Computed:    true,
+			},
+			"network_id": schema.StringAttribute{
+				Description: "The network ID whose snapshots are listed. Defaults to the default_network_id of the provider.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Only return snapshots in this processing state, such as PROCESSED.",
+				Optional:    true,
+			},
+			"created_after": schema.StringAttribute{
+				Description: "Only return snapshots created at or after this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"created_before": schema.StringAttribute{
+				Description: "Only return snapshots created before this RFC 3339 timestamp.",
+				Optional:    true,
+			},
+			"latest_processed_id": schema.StringAttribute{
+				Description: "The ID of the most recently created processed snapshot of the network, regardless of the filters.",
+				Computed:    true,
+			},
+			"snapshots": schema.ListNestedAttribute{
+				Description: "The snapshots matching the filters, newest first.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "Identifier of the snapshot.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Processing state of the snapshot.",
+							Computed:    true,
+						},
+						"created_at": schema.Int64Attribute{
+							Description: "Creation time of the snapshot, in milliseconds since the Unix epoch.",
+							Computed:    true,
+						},
+						"processed_at": schema.Int64Attribute{
+							Description: "Time the snapshot finished processing, in milliseconds since the Unix epoch. Null if it has not been processed.",
+							Computed:    true,
+						},
+						"is_latest": schema.BoolAttribute{
+							Description: "Whether this is the most recently created snapshot of the network.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *snapshotsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data := req.ProviderData.(*providerData)
+	d.client = data.client
+	d.defaults = data.defaults
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *snapshotsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state snapshotsDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.NetworkID = d.defaults.resolveNetworkID(state.NetworkID, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var createdAfter, createdBefore time.Time
+	if !state.CreatedAfter.IsNull() {
+		t, err := time.Parse(time.RFC3339, state.CreatedAfter.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("created_after"),
+				"Invalid Timestamp",
+				"The created_after value must be an RFC 3339 timestamp: "+err.Error(),
+			)
+		}
+		createdAfter = t
+	}
+	if !state.CreatedBefore.IsNull() {
+		t, err := time.Parse(time.RFC3339, state.CreatedBefore.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("created_before"),
+				"Invalid Timestamp",
+				"The created_before value must be an RFC 3339 timestamp: "+err.Error(),
+			)
+		}
+		createdBefore = t
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	snapshots, err := d.client.GetSnapshots(state.NetworkID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Forward Networks Snapshots",
+			"Could not read snapshots of network ID "+state.NetworkID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].CreatedAt > snapshots[j].CreatedAt
+	})
+
+	state.LatestProcessedID = types.StringNull()
+	for _, snapshot := range snapshots {
+		if snapshot.State == snapshotStateProcessed {
+			state.LatestProcessedID = types.StringValue(snapshot.ID)
+			break
+		}
+	}
+
+	state.Snapshots = []snapshotModel{}
+	for i, snapshot := range snapshots {
+		if !state.State.IsNull() && !strings.EqualFold(snapshot.State, state.State.ValueString()) {
+			continue
+		}
+		createdAt := time.UnixMilli(snapshot.CreatedAt)
+		if !createdAfter.IsZero() && createdAt.Before(createdAfter) {
+			continue
+		}
+		if !createdBefore.IsZero() && !createdAt.Before(createdBefore) {
+			continue
+		}
+
+		processedAt := types.Int64Null()
+		if snapshot.ProcessedAt != 0 {
+			processedAt = types.Int64Value(snapshot.ProcessedAt)
+		}
+		state.Snapshots = append(state.Snapshots, snapshotModel{
+			ID:          types.StringValue(snapshot.ID),
+			State:       types.StringValue(snapshot.State),
+			CreatedAt:   types.Int64Value(snapshot.CreatedAt),
+			ProcessedAt: processedAt,
+			IsLatest:    types.BoolValue(i == 0),
+		})
+	}
+
+	state.ID = types.StringValue(state.NetworkID.ValueString())
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
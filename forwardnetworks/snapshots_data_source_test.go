@@ -0,0 +1,58 @@
+package forwardnetworks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSnapshotsDataSource(t *testing.T) {
+	srv := newFakeServer(t)
+	networkID := srv.addNetwork("snapshots")
+	base := time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	oldest := srv.addSnapshot(networkID, snapshotStateProcessed, base)
+	processed := srv.addSnapshot(networkID, snapshotStateProcessed, base.Add(24*time.Hour))
+	failed := srv.addSnapshot(networkID, "FAILED", base.Add(48*time.Hour))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_snapshots" "test" {
+  network_id = "` + networkID + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshots.test", "snapshots.#", "3"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshots.test", "snapshots.0.id", failed),
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshots.test", "snapshots.0.is_latest", "true"),
+					resource.TestCheckNoResourceAttr("data.forwardnetworks_snapshots.test", "snapshots.0.processed_at"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshots.test", "snapshots.1.id", processed),
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshots.test", "snapshots.1.is_latest", "false"),
+					resource.TestCheckResourceAttrSet("data.forwardnetworks_snapshots.test", "snapshots.1.processed_at"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshots.test", "snapshots.2.id", oldest),
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshots.test", "latest_processed_id", processed),
+				),
+			},
+			// Filter testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_snapshots" "test" {
+  network_id     = "` + networkID + `"
+  state          = "PROCESSED"
+  created_after  = "2023-04-01T13:00:00Z"
+  created_before = "2023-04-03T00:00:00Z"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshots.test", "snapshots.#", "1"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshots.test", "snapshots.0.id", processed),
+					resource.TestCheckResourceAttr("data.forwardnetworks_snapshots.test", "snapshots.0.state", snapshotStateProcessed),
+				),
+			},
+		},
+	})
+}
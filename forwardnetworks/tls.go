@@ -0,0 +1,120 @@
+package forwardnetworks
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// tlsSettings holds the resolved TLS configuration of the provider.
+type tlsSettings struct {
+	Insecure      bool
+	CACertFile    string
+	CACertPEM     string
+	ClientCert    string
+	ClientKey     string
+	TLSServerName string
+}
+
+// tlsConfig builds the TLS configuration used to connect to the Forward
+// Networks API, adding attribute errors for certificates that cannot be read
+// or parsed. Custom CA certificates are trusted in addition to the system
+// certificate pool.
+func (s tlsSettings) tlsConfig(diags *diag.Diagnostics) *tls.Config {
+	config := &tls.Config{
+		InsecureSkipVerify: s.Insecure,
+		ServerName:         s.TLSServerName,
+	}
+
+	if s.CACertFile != "" || s.CACertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if s.CACertFile != "" {
+			data, err := os.ReadFile(s.CACertFile)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("ca_cert_file"),
+					"Unable to Read Forward Networks CA Certificate",
+					"The provider cannot read the CA certificate file "+s.CACertFile+": "+err.Error(),
+				)
+			} else if !pool.AppendCertsFromPEM(data) {
+				diags.AddAttributeError(
+					path.Root("ca_cert_file"),
+					"Invalid Forward Networks CA Certificate",
+					"The CA certificate file "+s.CACertFile+" does not contain any PEM-encoded certificates.",
+				)
+			}
+		}
+
+		if s.CACertPEM != "" && !pool.AppendCertsFromPEM([]byte(s.CACertPEM)) {
+			diags.AddAttributeError(
+				path.Root("ca_cert_pem"),
+				"Invalid Forward Networks CA Certificate",
+				"The ca_cert_pem value does not contain any PEM-encoded certificates.",
+			)
+		}
+
+		config.RootCAs = pool
+	}
+
+	if s.ClientCert == "" && s.ClientKey == "" {
+		return config
+	}
+	if s.ClientCert == "" || s.ClientKey == "" {
+		diags.AddAttributeError(
+			path.Root("client_cert"),
+			"Incomplete Forward Networks Client Certificate",
+			"Both client_cert and client_key must be set to present a client certificate.",
+		)
+		return config
+	}
+
+	certPEM, certErr := readPEM(s.ClientCert)
+	if certErr != nil {
+		diags.AddAttributeError(
+			path.Root("client_cert"),
+			"Unable to Read Forward Networks Client Certificate",
+			"The provider cannot read the client certificate file "+s.ClientCert+": "+certErr.Error(),
+		)
+	}
+	keyPEM, keyErr := readPEM(s.ClientKey)
+	if keyErr != nil {
+		diags.AddAttributeError(
+			path.Root("client_key"),
+			"Unable to Read Forward Networks Client Key",
+			"The provider cannot read the client key file "+s.ClientKey+": "+keyErr.Error(),
+		)
+	}
+	if certErr != nil || keyErr != nil {
+		return config
+	}
+
+	certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("client_cert"),
+			"Invalid Forward Networks Client Certificate",
+			"The client certificate and key are not a valid PEM-encoded key pair: "+err.Error(),
+		)
+		return config
+	}
+	config.Certificates = []tls.Certificate{certificate}
+
+	return config
+}
+
+// readPEM returns value itself when it holds PEM-encoded data and otherwise
+// reads the file it names.
+func readPEM(value string) ([]byte, error) {
+	if strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
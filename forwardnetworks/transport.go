@@ -0,0 +1,204 @@
+package forwardnetworks
+
+import (
+	"context"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default retry settings used when the provider configuration omits them.
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = 1 * time.Second
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// tokenAuthTransport is an http.RoundTripper that authenticates every request
+// with a Forward Networks API token.
+type tokenAuthTransport struct {
+	token string
+	next  http.RoundTripper
+}
+
+// RoundTrip sets the Authorization header and forwards the request to the
+// wrapped transport.
+func (t *tokenAuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+t.token)
+
+	return roundTripper(t.next).RoundTrip(req)
+}
+
+// retryTransport is an http.RoundTripper that retries requests failing with a
+// transient error, waiting with exponential backoff between attempts.
+type retryTransport struct {
+	next       http.RoundTripper
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+	// timeout bounds each attempt, including reading the response body.
+	// Zero means no timeout. Streamed request and response bodies, such as
+	// uploaded and downloaded snapshot archives, are only bounded by the
+	// request context.
+	timeout time.Duration
+}
+
+// RoundTrip sends the request, retrying it on transient errors up to
+// maxRetries times. See shouldRetry for which errors are retried.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		ctx, cancel := req.Context(), context.CancelFunc(func() {})
+		if t.timeout > 0 && !streamedBody(req) && !streamedResponse(req) {
+			ctx, cancel = context.WithTimeout(req.Context(), t.timeout)
+		}
+		resp, err := roundTripper(t.next).RoundTrip(attemptReq.WithContext(ctx))
+
+		if attempt >= t.maxRetries || !t.shouldRetry(req, resp, err) {
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+			return resp, nil
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+		}
+		cancel()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// shouldRetry reports whether an attempt failed with a transient error.
+// Connection errors and 502 and 504 responses are only retried for idempotent
+// requests, since the server may have processed a request whose response was
+// lost. A 429 or 503 response means the request was not processed.
+func (t *retryTransport) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if streamedBody(req) {
+		return false
+	}
+	if err != nil {
+		return idempotent(req)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		return idempotent(req)
+	}
+	return false
+}
+
+// idempotent reports whether sending req more than once has the same effect
+// as sending it once.
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// streamedBody reports whether req has a body that is read only once and
+// cannot be replayed.
+func streamedBody(req *http.Request) bool {
+	return req.Body != nil && req.Body != http.NoBody && req.GetBody == nil
+}
+
+// streamedResponse reports whether req downloads a file, such as a snapshot
+// archive, whose response body is streamed to the caller.
+func streamedResponse(req *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(req.Header.Get("Accept"))
+	return err == nil && (mediaType == "application/zip" || mediaType == "application/octet-stream")
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After
+// header in the response takes precedence over the exponential backoff, but
+// never exceeds maxWait.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > t.maxWait {
+				return t.maxWait
+			}
+			return wait
+		}
+	}
+
+	wait := t.minWait
+	for i := 0; i < attempt && wait < t.maxWait; i++ {
+		wait *= 2
+	}
+	if wait > t.maxWait {
+		return t.maxWait
+	}
+	return wait
+}
+
+// retryAfter parses a Retry-After header value, which is either a number of
+// seconds or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// cancelOnCloseBody releases the context of a request attempt once its
+// response body has been closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the response body and cancels the attempt context.
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+// roundTripper returns rt, or http.DefaultTransport when rt is nil.
+func roundTripper(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		return http.DefaultTransport
+	}
+	return rt
+}
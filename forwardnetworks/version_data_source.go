@@ -1,88 +1,251 @@
-package forwardnetworks
-
-import (
-	"context"
-	"github.com/forwardnetworks/forwardnetworks-client-go"
-	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/types"
-)
-
-// Ensure the implementation satisfies the expected interfaces.
-var (
-	_ datasource.DataSource              = &versionDataSource{}
-	_ datasource.DataSourceWithConfigure = &versionDataSource{}
-)
-
-// NewVersionDataSource is a helper function to simplify the provider implementation.
-func NewVersionDataSource() datasource.DataSource {
-	return &versionDataSource{}
-}
-
-// versionDataSource is the data source implementation.
-type versionDataSource struct {
-	client *forwardnetworks.Client
-}
-
-// versionDataSourceModel maps the data source schema data.
-type versionDataSourceModel struct {
-	ID      types.String `tfsdk:"id"`
-	Version      types.String `tfsdk:"version"`
-}
-
-// Metadata returns the data source type name.
-func (d *versionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_version"
-}
-
-// versionDataSourceModel maps the data source schema data.
-
-
-// Schema defines the schema for the data source.
-func (d *versionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Description: "Fetches the Forward Networks API version.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				Description: "Placeholder identifier attribute.",
-                Computed: true,
-            },
-            "version": schema.StringAttribute{
-				Description: "The version of the Forward Networks API.",
-				Computed:    true,
-			},
-		},
-	}
-}
-
-// Configure adds the provider configured client to the data source.
-func (d *versionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-
-	d.client = req.ProviderData.(*forwardnetworks.Client)
-}
-
-// Read refreshes the Terraform state with the latest data.
-func (d *versionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state versionDataSourceModel
-
-	version, err := d.client.GetVersion()
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Unable to Read Forward Networks Version",
-			err.Error(),
-		)
-		return
-	}
-
-	state.ID = types.StringValue(version.Version)
-
-	// Set state
-	diags := resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-}
+package forwardnetworks
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/forwardnetworks/forwardnetworks-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Deployment types reported by the version data source.
+const (
+	deploymentTypeSaaS   = "saas"
+	deploymentTypeOnPrem = "on_prem"
+)
+
+// saasDomain is the domain of the Forward Networks SaaS deployment.
+const saasDomain = "fwd.app"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                   = &versionDataSource{}
+	_ datasource.DataSourceWithConfigure      = &versionDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &versionDataSource{}
+)
+
+// NewVersionDataSource is a helper function to simplify the provider implementation.
+func NewVersionDataSource() datasource.DataSource {
+	return &versionDataSource{}
+}
+
+// versionDataSource is the data source implementation.
+type versionDataSource struct {
+	client *forwardnetworks.Client
+}
+
+// versionDataSourceModel maps the data source schema data.
+type versionDataSourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Version        types.String `tfsdk:"version"`
+	Major          types.Int64  `tfsdk:"major"`
+	Minor          types.Int64  `tfsdk:"minor"`
+	Patch          types.Int64  `tfsdk:"patch"`
+	Build          types.String `tfsdk:"build"`
+	DeploymentType types.String `tfsdk:"deployment_type"`
+	MinimumVersion types.String `tfsdk:"minimum_version"`
+}
+
+// Metadata returns the data source type name.
+func (d *versionDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_version"
+}
+
+// Schema defines the schema for the data source.
+func (d *versionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the Forward Networks API version.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The version of the Forward Networks API.",
+				Computed:    true,
+			},
+			"version": schema.StringAttribute{
+				Description: "The version of the Forward Networks API, such as 23.4.1-01.",
+				Computed:    true,
+			},
+			"major": schema.Int64Attribute{
+				Description: "The major version number. Null if the version cannot be parsed.",
+				Computed:    true,
+			},
+			"minor": schema.Int64Attribute{
+				Description: "The minor version number. Null if the version cannot be parsed.",
+				Computed:    true,
+			},
+			"patch": schema.Int64Attribute{
+				Description: "The patch version number. Null if the version cannot be parsed.",
+				Computed:    true,
+			},
+			"build": schema.StringAttribute{
+				Description: "The build identifier following the version number, such as 01. Null if the version has none.",
+				Computed:    true,
+			},
+			"deployment_type": schema.StringAttribute{
+				Description: "How Forward Networks is likely deployed: " + deploymentTypeSaaS + " for the Forward Networks SaaS at " + saasDomain +
+					", otherwise " + deploymentTypeOnPrem + ". The API does not report this, so it is guessed from the host name; " +
+					"a custom domain of the SaaS is reported as " + deploymentTypeOnPrem + ".",
+				Computed: true,
+			},
+			"minimum_version": schema.StringAttribute{
+				Description: "The minimum supported version, such as 23.4 or 23.4.1. Reading the data source fails when the Forward Networks API is older.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures minimum_version is a valid version number.
+func (d *versionDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var config versionDataSourceModel
+
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.MinimumVersion.IsNull() || config.MinimumVersion.IsUnknown() {
+		return
+	}
+
+	if _, ok := parseServerVersion(config.MinimumVersion.ValueString()); !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("minimum_version"),
+			"Invalid Minimum Version",
+			"The minimum_version value must be a version number such as 23.4 or 23.4.1, got: "+config.MinimumVersion.ValueString(),
+		)
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *versionDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	d.client = req.ProviderData.(*providerData).client
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *versionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state versionDataSourceModel
+
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	version, err := d.client.GetVersion()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Forward Networks Version",
+			err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(version.Version)
+	state.Version = types.StringValue(version.Version)
+	state.DeploymentType = types.StringValue(deploymentType(d.client.HostURL))
+
+	state.Major = types.Int64Null()
+	state.Minor = types.Int64Null()
+	state.Patch = types.Int64Null()
+	state.Build = types.StringNull()
+	current, ok := parseServerVersion(version.Version)
+	if ok {
+		state.Major = types.Int64Value(current.major)
+		state.Minor = types.Int64Value(current.minor)
+		state.Patch = types.Int64Value(current.patch)
+		if current.build != "" {
+			state.Build = types.StringValue(current.build)
+		}
+	}
+
+	if !state.MinimumVersion.IsNull() {
+		minimum, _ := parseServerVersion(state.MinimumVersion.ValueString())
+		if !ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("minimum_version"),
+				"Unable to Check Forward Networks Version",
+				"The version "+version.Version+" reported by the Forward Networks API cannot be compared with minimum_version.",
+			)
+			return
+		}
+		if current.less(minimum) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("minimum_version"),
+				"Unsupported Forward Networks Version",
+				fmt.Sprintf("The Forward Networks API at %s is version %s, which is older than the minimum version %s.",
+					d.client.HostURL, version.Version, state.MinimumVersion.ValueString()),
+			)
+			return
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// serverVersion is a parsed Forward Networks version such as 23.4.1-01.
+type serverVersion struct {
+	major, minor, patch int64
+	build               string
+}
+
+// parseServerVersion parses a version of the form major.minor[.patch][-build].
+func parseServerVersion(version string) (serverVersion, bool) {
+	var v serverVersion
+	number, build, _ := strings.Cut(strings.TrimSpace(version), "-")
+	v.build = build
+
+	parts := strings.Split(number, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return v, false
+	}
+	fields := []*int64{&v.major, &v.minor, &v.patch}
+	for i, part := range parts {
+		n, err := strconv.ParseInt(part, 10, 64)
+		if err != nil || n < 0 {
+			return v, false
+		}
+		*fields[i] = n
+	}
+	return v, true
+}
+
+// less reports whether v is an older release than other, ignoring the build.
+func (v serverVersion) less(other serverVersion) bool {
+	if v.major != other.major {
+		return v.major < other.major
+	}
+	if v.minor != other.minor {
+		return v.minor < other.minor
+	}
+	return v.patch < other.patch
+}
+
+// deploymentType guesses the deployment type of the Forward Networks API at
+// hostURL from its host name, since the API does not report it.
+func deploymentType(hostURL string) string {
+	u, err := url.Parse(hostURL)
+	if err != nil {
+		return deploymentTypeOnPrem
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == saasDomain || strings.HasSuffix(host, "."+saasDomain) {
+		return deploymentTypeSaaS
+	}
+	return deploymentTypeOnPrem
+}
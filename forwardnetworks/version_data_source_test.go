@@ -0,0 +1,108 @@
+package forwardnetworks
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVersionDataSource(t *testing.T) {
+	srv := newFakeServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_version" "test" {
+  minimum_version = "23.4"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.forwardnetworks_version.test", "id", "23.4.1-01"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_version.test", "version", "23.4.1-01"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_version.test", "major", "23"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_version.test", "minor", "4"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_version.test", "patch", "1"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_version.test", "build", "01"),
+					resource.TestCheckResourceAttr("data.forwardnetworks_version.test", "deployment_type", deploymentTypeOnPrem),
+				),
+			},
+			// Minimum version testing
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_version" "test" {
+  minimum_version = "23.10"
+}
+`,
+				ExpectError: regexp.MustCompile(`Unsupported Forward Networks Version`),
+			},
+			{
+				Config: srv.providerConfig() + `
+data "forwardnetworks_version" "test" {
+  minimum_version = "latest"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Minimum Version`),
+			},
+		},
+	})
+}
+
+func TestParseServerVersion(t *testing.T) {
+	for version, want := range map[string]serverVersion{
+		"23.4.1-01":         {major: 23, minor: 4, patch: 1, build: "01"},
+		"24.10":             {major: 24, minor: 10},
+		"24.2.0-03-hotfix1": {major: 24, minor: 2, build: "03-hotfix1"},
+	} {
+		got, ok := parseServerVersion(version)
+		if !ok {
+			t.Errorf("%s: expected the version to parse", version)
+			continue
+		}
+		if got != want {
+			t.Errorf("%s: expected %+v, got %+v", version, want, got)
+		}
+	}
+
+	for _, version := range []string{"", "23", "23.x", "23.4.1.2", "v23.4"} {
+		if _, ok := parseServerVersion(version); ok {
+			t.Errorf("%q: expected the version to be invalid", version)
+		}
+	}
+}
+
+func TestServerVersion_less(t *testing.T) {
+	for _, tc := range []struct {
+		version, other string
+		less           bool
+	}{
+		{"23.4.1-01", "23.10", true},
+		{"23.10", "23.4.1", false},
+		{"23.4.1-01", "23.4.1", false},
+		{"23.4.0", "23.4.1", true},
+		{"22.12.5", "23.1", true},
+	} {
+		v, _ := parseServerVersion(tc.version)
+		other, _ := parseServerVersion(tc.other)
+		if got := v.less(other); got != tc.less {
+			t.Errorf("%s < %s: expected %t, got %t", tc.version, tc.other, tc.less, got)
+		}
+	}
+}
+
+func TestDeploymentType(t *testing.T) {
+	for hostURL, want := range map[string]string{
+		"https://fwd.app":               deploymentTypeSaaS,
+		"https://eu.FWD.app:443":        deploymentTypeSaaS,
+		"https://fwd.example.com":       deploymentTypeOnPrem,
+		"https://notfwd.app":            deploymentTypeOnPrem,
+		"https://fwd.app.example.com/x": deploymentTypeOnPrem,
+	} {
+		if got := deploymentType(hostURL); got != want {
+			t.Errorf("%s: expected %s, got %s", hostURL, want, got)
+		}
+	}
+}
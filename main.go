@@ -1,17 +1,17 @@
-package main
-
-import (
-    "context"
-    "terraform-provider-fwdnet/fwdnet"
-
-    "github.com/hashicorp/terraform-plugin-framework/providerserver"
-)
-
-// Provider documentation generation.
-//go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs generate --provider-name fwdnet
-
-func main() {
-    providerserver.Serve(context.Background(), fwdnet.New, providerserver.ServeOpts{
-        Address: "registry.terraform.io/fracticated/fwdnet",
-    })
-}
+package main
+
+import (
+	"context"
+	"terraform-provider-fwdnet/forwardnetworks"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+)
+
+// Provider documentation generation.
+//go:generate go run github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs generate --provider-name fwdnet
+
+func main() {
+	providerserver.Serve(context.Background(), forwardnetworks.New, providerserver.ServeOpts{
+		Address: "registry.terraform.io/fracticated/fwdnet",
+	})
+}